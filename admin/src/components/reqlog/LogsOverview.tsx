@@ -30,7 +30,7 @@ function LogsOverview(): JSX.Element {
     return <CircularProgress />;
   }
   if (error) {
-    if (error.graphQLErrors[0]?.extensions?.code === "no_active_project") {
+    if (error.graphQLErrors[0]?.extensions?.code === "NO_ACTIVE_PROJECT") {
       return (
         <Alert severity="info">
           There is no project active.{" "}
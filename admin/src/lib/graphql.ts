@@ -3,11 +3,40 @@ import { ApolloClient, HttpLink, InMemoryCache } from "@apollo/client";
 
 let apolloClient;
 
+const apiTokenKey = "hettyApiToken";
+
+// Adds the API token (when Hetty runs with `-api-token`) to requests. On a
+// `401 Unauthorized` response, the user is prompted for the token, which is
+// then stored in local storage and used to retry the request.
+async function fetchWithApiToken(
+  uri: RequestInfo,
+  options: RequestInit = {}
+): Promise<Response> {
+  const withToken = (token: string | null): RequestInit => {
+    if (!token) return options;
+    const headers = new Headers(options.headers);
+    headers.set("Authorization", `Bearer ${token}`);
+    return { ...options, headers };
+  };
+
+  const res = await fetch(uri, withToken(localStorage.getItem(apiTokenKey)));
+  if (res.status !== 401) return res;
+
+  const token = window.prompt("Enter the API token Hetty was started with:");
+  if (!token) return res;
+
+  localStorage.setItem(apiTokenKey, token);
+  return fetch(uri, withToken(token));
+}
+
 function createApolloClient() {
+  const ssrMode = typeof window === "undefined";
+
   return new ApolloClient({
-    ssrMode: typeof window === "undefined",
+    ssrMode,
     link: new HttpLink({
       uri: "/api/graphql/",
+      fetch: ssrMode ? undefined : fetchWithApiToken,
     }),
     cache: new InMemoryCache({
       typePolicies: {
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// isAdminRequest reports whether req is addressed to Hetty itself, rather than
+// proxied.
+func isAdminRequest(req *http.Request, _ *mux.RouteMatch) bool {
+	hostname, _ := os.Hostname()
+	host, _, _ := net.SplitHostPort(req.Host)
+
+	return strings.EqualFold(host, hostname) || (req.Host == "hetty.proxy" || req.Host == "localhost:8080")
+}
+
+// mountAdmin mounts the admin interface and API with routes. If apiRouter is
+// nil, they're mounted on the requests of router that are addressed to Hetty
+// itself. Otherwise, they're only mounted on apiRouter, so they're only served
+// by the API listener, and such requests to router get a 404.
+func mountAdmin(router, apiRouter *mux.Router, routes func(*mux.Router)) {
+	adminRouter := router.MatcherFunc(isAdminRequest).Subrouter().StrictSlash(true)
+
+	if apiRouter == nil {
+		routes(adminRouter)
+		return
+	}
+
+	routes(apiRouter)
+	adminRouter.PathPrefix("").Handler(http.NotFoundHandler())
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+func TestMountAdmin(t *testing.T) {
+	t.Parallel()
+
+	routes := func(r *mux.Router) {
+		r.Path("/api/graphql/").Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+	}
+
+	// Stands in for the proxy, which handles requests that aren't addressed to
+	// Hetty itself.
+	proxy := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	tests := []struct {
+		name         string
+		separateAPI  bool
+		expProxyCode int
+	}{
+		{name: "proxy listener", separateAPI: false, expProxyCode: http.StatusNoContent},
+		{name: "separate API listener", separateAPI: true, expProxyCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter().SkipClean(true)
+
+			var apiRouter *mux.Router
+			if tt.separateAPI {
+				apiRouter = mux.NewRouter().SkipClean(true).StrictSlash(true)
+			}
+
+			mountAdmin(router, apiRouter, routes)
+			router.PathPrefix("").Handler(proxy)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "http://hetty.proxy/api/graphql/", nil))
+
+			if rec.Code != tt.expProxyCode {
+				t.Errorf("expected proxy listener status %v, got: %v", tt.expProxyCode, rec.Code)
+			}
+
+			if apiRouter == nil {
+				return
+			}
+
+			rec = httptest.NewRecorder()
+			apiRouter.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "http://localhost:8443/api/graphql/", nil))
+
+			if rec.Code != http.StatusNoContent {
+				t.Errorf("expected API listener status %v, got: %v", http.StatusNoContent, rec.Code)
+			}
+		})
+	}
+}
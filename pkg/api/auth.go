@@ -0,0 +1,34 @@
+package api
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+// BearerAuthHandler returns a handler that only passes requests to next if
+// they have an `Authorization` header with the bearer token. Other requests
+// get a `401 Unauthorized` response.
+func BearerAuthHandler(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !validBearerToken(r.Header.Get("Authorization"), token) {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="hetty"`)
+			http.Error(w, "Unauthorized.", http.StatusUnauthorized)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func validBearerToken(header, token string) bool {
+	const prefix = "Bearer "
+
+	// The authentication scheme is case-insensitive.
+	if len(header) < len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(header[len(prefix):]), []byte(token)) == 1
+}
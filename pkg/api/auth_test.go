@@ -0,0 +1,52 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBearerAuthHandler(t *testing.T) {
+	t.Parallel()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	h := BearerAuthHandler("s3cr3t", next)
+
+	tests := []struct {
+		name      string
+		header    string
+		expStatus int
+	}{
+		{name: "missing token", header: "", expStatus: http.StatusUnauthorized},
+		{name: "wrong token", header: "Bearer foobar", expStatus: http.StatusUnauthorized},
+		{name: "token prefix", header: "Bearer s3cr3", expStatus: http.StatusUnauthorized},
+		{name: "wrong scheme", header: "Basic s3cr3t", expStatus: http.StatusUnauthorized},
+		{name: "correct token", header: "Bearer s3cr3t", expStatus: http.StatusNoContent},
+		{name: "case-insensitive scheme", header: "bearer s3cr3t", expStatus: http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/graphql/", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.expStatus {
+				t.Errorf("expected status %v, got: %v", tt.expStatus, rec.Code)
+			}
+
+			if tt.expStatus == http.StatusUnauthorized && rec.Header().Get("WWW-Authenticate") == "" {
+				t.Error("expected `WWW-Authenticate` header to be set")
+			}
+		})
+	}
+}
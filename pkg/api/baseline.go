@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func (r *queryResolver) CompareToBaseline(
+	ctx context.Context,
+	id int64,
+	baselineID int64,
+) (*HTTPBaselineComparison, error) {
+	comparison, err := r.RequestLogService.CompareToBaseline(ctx, id, baselineID)
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, reqlog.ErrRequestNotFound):
+		return nil, notFoundErr(ctx, "Request log not found.")
+	case errors.Is(err, reqlog.ErrNotBaseline):
+		return nil, newError(ctx, ErrCodeBadInput, fmt.Sprintf("Request log %v is not a baseline.", baselineID))
+	case errors.Is(err, reqlog.ErrNoResponse):
+		return nil, newError(ctx, ErrCodeBadInput, "Request log has no response to compare.")
+	case err != nil:
+		return nil, fmt.Errorf("could not compare request log to baseline: %w", err)
+	}
+
+	result := &HTTPBaselineComparison{
+		StatusChanged:      comparison.StatusChanged(),
+		StatusCode:         comparison.StatusCode,
+		BaselineStatusCode: comparison.BaselineStatusCode,
+		BodySizeDelta:      comparison.BodySizeDelta(),
+		BodySize:           comparison.BodySize,
+		BaselineBodySize:   comparison.BaselineBodySize,
+		BodyChanged:        comparison.BodyChanged,
+		Headers:            make([]HTTPHeaderDiff, len(comparison.Headers)),
+		BodyDiff:           make([]HTTPBodyLineDiff, len(comparison.BodyDiff)),
+		BodyDiffTruncated:  comparison.BodyDiffTruncated,
+	}
+
+	for i, header := range comparison.Headers {
+		result.Headers[i] = HTTPHeaderDiff{
+			Key:            header.Key,
+			Values:         append([]string{}, header.Values...),
+			BaselineValues: append([]string{}, header.BaselineValues...),
+		}
+	}
+
+	for i, line := range comparison.BodyDiff {
+		op := DiffOpInsert
+		if line.Op == reqlog.DiffDelete {
+			op = DiffOpDelete
+		}
+
+		result.BodyDiff[i] = HTTPBodyLineDiff{Op: op, Line: line.Line, Text: line.Text}
+	}
+
+	return result, nil
+}
+
+func (r *mutationResolver) SetHTTPRequestLogBaseline(ctx context.Context, id int64, baseline bool) (*HTTPRequestLog, error) {
+	err := r.RequestLogService.SetBaseline(ctx, id, baseline)
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, reqlog.ErrRequestNotFound):
+		return nil, notFoundErr(ctx, "Request log not found.")
+	case err != nil:
+		return nil, fmt.Errorf("could not set request log baseline: %w", err)
+	}
+
+	reqLog, err := r.RequestLogService.FindRequestLogByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("could not get request by ID: %w", err)
+	}
+
+	log := parseRequestLog(reqLog)
+
+	return &log, nil
+}
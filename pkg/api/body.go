@@ -0,0 +1,67 @@
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+// decodeBody decodes a body that's encoded as described by its
+// `Content-Encoding` header. The raw body is returned, with decoded set to
+// false, if the body isn't encoded, or if any of its encodings is unsupported
+// or invalid.
+//
+// Transfer encodings (e.g. `chunked`) aren't considered; they have already
+// been removed from stored bodies.
+func decodeBody(header http.Header, body []byte) (decoded []byte, ok bool) {
+	var encodings []string
+
+	for _, value := range header.Values("Content-Encoding") {
+		for _, encoding := range strings.Split(value, ",") {
+			encoding = strings.ToLower(strings.TrimSpace(encoding))
+			if encoding != "" && encoding != "identity" {
+				encodings = append(encodings, encoding)
+			}
+		}
+	}
+
+	if len(encodings) == 0 || len(body) == 0 {
+		return body, false
+	}
+
+	decoded = body
+
+	// Encodings are listed in the order they were applied.
+	for i := len(encodings) - 1; i >= 0; i-- {
+		var err error
+
+		switch encodings[i] {
+		case "gzip", "x-gzip":
+			decoded, err = gunzip(decoded)
+		case "br":
+			decoded, err = ioutil.ReadAll(brotli.NewReader(bytes.NewReader(decoded)))
+		default:
+			return body, false
+		}
+
+		if err != nil {
+			return body, false
+		}
+	}
+
+	return decoded, true
+}
+
+func gunzip(body []byte) ([]byte, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+
+	return ioutil.ReadAll(gzipReader)
+}
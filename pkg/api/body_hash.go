@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+)
+
+func (r *queryResolver) RequestsByBodyHash(ctx context.Context, hash string) ([]HTTPRequestLog, error) {
+	reqs, err := r.RequestLogService.FindRequestsByBodyHash(ctx, hash)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get requests by body hash: %w", err)
+	}
+
+	logs := make([]HTTPRequestLog, len(reqs))
+
+	for i, req := range reqs {
+		logs[i] = parseRequestLog(req)
+	}
+
+	return logs, nil
+}
+
+func (r *queryResolver) TopBodyHashes(ctx context.Context, limit *int) ([]BodyHashCount, error) {
+	var n int
+	if limit != nil {
+		n = *limit
+	}
+
+	hashCounts, err := r.RequestLogService.TopBodyHashes(ctx, n)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get top body hashes: %w", err)
+	}
+
+	result := make([]BodyHashCount, len(hashCounts))
+	for i, hashCount := range hashCounts {
+		result[i] = BodyHashCount{
+			Hash:         hashCount.Hash,
+			Count:        int(hashCount.Count),
+			RequestCount: int(hashCount.RequestCount),
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,263 @@
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/andybalholm/brotli"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func gzipBytes(t *testing.T, b []byte) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buf)
+
+	if _, err := gzipWriter.Write(b); err != nil {
+		t.Fatalf("could not write gzip data: %v", err)
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatalf("could not close gzip writer: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func brotliBytes(t *testing.T, b []byte) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	brotliWriter := brotli.NewWriter(buf)
+
+	if _, err := brotliWriter.Write(b); err != nil {
+		t.Fatalf("could not write brotli data: %v", err)
+	}
+
+	if err := brotliWriter.Close(); err != nil {
+		t.Fatalf("could not close brotli writer: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+// captureRequestLog sends a request through a proxy that logs to the request
+// log service of a test resolver, to a target that responds using handler. It
+// returns the stored log, once its response is stored.
+func captureRequestLog(t *testing.T, header http.Header, body []byte, handler http.HandlerFunc) reqlog.Request {
+	t.Helper()
+
+	resolver, db := newTestResolver(t)
+	svc := resolver.RequestLogService
+
+	target := httptest.NewServer(handler)
+	t.Cleanup(target.Close)
+
+	caCert, caKey, err := proxy.NewCA("Hetty", "Hetty CA", time.Hour)
+	if err != nil {
+		t.Fatalf("could not create CA: %v", err)
+	}
+
+	p, err := proxy.NewProxy(proxy.Config{CACert: caCert, CAKey: caKey})
+	if err != nil {
+		t.Fatalf("could not create proxy: %v", err)
+	}
+
+	p.UseRequestModifier(svc.RequestModifier)
+	p.UseResponseModifier(svc.ResponseModifier)
+
+	proxyServer := httptest.NewServer(p)
+	t.Cleanup(proxyServer.Close)
+
+	proxyURL, err := url.Parse(proxyServer.URL)
+	if err != nil {
+		t.Fatalf("could not parse proxy URL: %v", err)
+	}
+
+	client := &http.Client{Transport: &http.Transport{
+		Proxy:              http.ProxyURL(proxyURL),
+		DisableCompression: true,
+	}}
+
+	// Without a known length, the request body is sent chunked.
+	req, err := http.NewRequest(http.MethodPost, target.URL, ioutil.NopCloser(bytes.NewReader(body)))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+
+	req.Header = header
+
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("could not send request: %v", err)
+	}
+
+	if _, err := io.Copy(ioutil.Discard, res.Body); err != nil {
+		t.Fatalf("could not read response body: %v", err)
+	}
+
+	res.Body.Close()
+
+	// The response log is stored asynchronously.
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		reqLogs, err := db.FindRequestLogs(context.Background(), reqlog.FindRequestsFilter{}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(reqLogs) == 1 && reqLogs[0].Response != nil {
+			return reqLogs[0]
+		}
+	}
+
+	t.Fatal("expected a request log with a response to be stored")
+
+	return reqlog.Request{}
+}
+
+func TestParseRequestLogDecodesBody(t *testing.T) {
+	t.Parallel()
+
+	gzipped := gzipBytes(t, []byte(`{"foo":"bar"}`))
+
+	tests := []struct {
+		name           string
+		header         http.Header
+		body           []byte
+		expBody        string
+		expBodyDecoded bool
+	}{
+		{
+			name:           "gzip",
+			header:         http.Header{"Content-Encoding": []string{"gzip"}},
+			body:           gzipped,
+			expBody:        `{"foo":"bar"}`,
+			expBodyDecoded: true,
+		},
+		{
+			name:           "brotli",
+			header:         http.Header{"Content-Encoding": []string{"br"}},
+			body:           brotliBytes(t, []byte(`{"foo":"bar"}`)),
+			expBody:        `{"foo":"bar"}`,
+			expBodyDecoded: true,
+		},
+		{
+			name:           "stacked encodings",
+			header:         http.Header{"Content-Encoding": []string{"gzip, br"}},
+			body:           brotliBytes(t, gzipped),
+			expBody:        `{"foo":"bar"}`,
+			expBodyDecoded: true,
+		},
+		{
+			name:           "unknown encoding",
+			header:         http.Header{"Content-Encoding": []string{"gzip, zstd"}},
+			body:           []byte("foobar"),
+			expBody:        "foobar",
+			expBodyDecoded: false,
+		},
+		{
+			name:           "not encoded",
+			header:         http.Header{"Content-Type": []string{"application/json"}},
+			body:           []byte(`{"foo":"bar"}`),
+			expBody:        `{"foo":"bar"}`,
+			expBodyDecoded: false,
+		},
+		{
+			name:           "invalid gzip data",
+			header:         http.Header{"Content-Encoding": []string{"gzip"}},
+			body:           []byte("foobar"),
+			expBody:        "foobar",
+			expBodyDecoded: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reqLog := captureRequestLog(t, tt.header, tt.body, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			got := parseRequestLog(reqLog)
+
+			if got.Body == nil || *got.Body != tt.expBody {
+				t.Errorf("expected body %q, got: %v", tt.expBody, got.Body)
+			}
+
+			if got.BodyDecoded != tt.expBodyDecoded {
+				t.Errorf("expected bodyDecoded to be %v, got: %v", tt.expBodyDecoded, got.BodyDecoded)
+			}
+
+			if !bytes.Equal(reqLog.Body, tt.body) {
+				t.Error("expected raw body to be left untouched")
+			}
+		})
+	}
+}
+
+func TestParseRequestLogDecodesResponseBody(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		header  http.Header
+		body    []byte
+		expBody string
+	}{
+		{
+			name:    "gzip",
+			header:  http.Header{"Content-Encoding": []string{"gzip"}},
+			body:    gzipBytes(t, []byte(`{"foo":"bar"}`)),
+			expBody: `{"foo":"bar"}`,
+		},
+		{
+			name:    "brotli",
+			header:  http.Header{"Content-Encoding": []string{"br"}},
+			body:    brotliBytes(t, []byte(`{"foo":"bar"}`)),
+			expBody: `{"foo":"bar"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reqHeader := http.Header{"Accept-Encoding": []string{"gzip, br"}}
+
+			reqLog := captureRequestLog(t, reqHeader, nil, func(w http.ResponseWriter, r *http.Request) {
+				for key, values := range tt.header {
+					w.Header()[key] = values
+				}
+				w.Write(tt.body)
+			})
+
+			got := parseRequestLog(reqLog)
+
+			if got.Response == nil {
+				t.Fatal("expected response log")
+			}
+
+			if got.Response.Body == nil || *got.Response.Body != tt.expBody {
+				t.Errorf("expected response body %q, got: %v", tt.expBody, got.Response.Body)
+			}
+
+			if !got.Response.BodyDecoded {
+				t.Error("expected response bodyDecoded to be true")
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func (r *mutationResolver) CreateHTTPRequestLog(
+	ctx context.Context,
+	input CreateHTTPRequestLogInput,
+) (*HTTPRequestLog, error) {
+	u, err := url.Parse(input.URL)
+	if err != nil {
+		return nil, newError(ctx, ErrCodeBadInput, fmt.Sprintf("Invalid URL: %v", err))
+	}
+
+	req := http.Request{
+		Method: string(input.Method),
+		URL:    u,
+		Header: make(http.Header),
+	}
+
+	if input.Proto != nil {
+		major, minor, ok := http.ParseHTTPVersion(*input.Proto)
+		if !ok {
+			return nil, newError(ctx, ErrCodeBadInput, fmt.Sprintf("Invalid protocol version: %q", *input.Proto))
+		}
+
+		req.Proto, req.ProtoMajor, req.ProtoMinor = *input.Proto, major, minor
+	}
+
+	for _, header := range input.Headers {
+		if header.Key == "Host" {
+			req.Host = header.Value
+			continue
+		}
+
+		req.Header.Add(header.Key, header.Value)
+	}
+
+	var body []byte
+	if input.Body != nil {
+		body = []byte(*input.Body)
+	}
+
+	reqLog, err := r.RequestLogService.CreateRequest(ctx, req, body)
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, reqlog.ErrInvalidRequest):
+		return nil, newError(ctx, ErrCodeBadInput, fmt.Sprintf("Invalid request: %v", err))
+	case err != nil:
+		return nil, fmt.Errorf("could not create request log: %w", err)
+	}
+
+	log := parseRequestLog(*reqLog)
+
+	return &log, nil
+}
+
+func (r *mutationResolver) ResendHTTPRequestLog(
+	ctx context.Context,
+	id int64,
+	options *ResendOptionsInput,
+) (*HTTPRequestLog, error) {
+	var opts reqlog.ResendOptions
+
+	if options != nil {
+		opts.RemoveHeaders = options.RemoveHeaders
+
+		if options.FollowRedirects != nil {
+			opts.FollowRedirects = *options.FollowRedirects
+		}
+
+		if len(options.SetHeaders) > 0 {
+			opts.SetHeaders = make(http.Header)
+
+			for _, header := range options.SetHeaders {
+				opts.SetHeaders.Add(header.Key, header.Value)
+			}
+		}
+	}
+
+	reqLog, err := r.RequestLogService.ResendRequest(ctx, id, opts)
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, reqlog.ErrRequestNotFound):
+		return nil, notFoundErr(ctx, "Request log not found.")
+	case errors.Is(err, reqlog.ErrInvalidRequest):
+		return nil, newError(ctx, ErrCodeBadInput, fmt.Sprintf("Invalid resend options: %v", err))
+	case err != nil:
+		return nil, fmt.Errorf("could not resend request: %w", err)
+	}
+
+	log := parseRequestLog(*reqLog)
+
+	return &log, nil
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Error codes, set as the `code` extension of GraphQL errors, so clients can
+// handle errors without relying on messages.
+const (
+	ErrCodeNotFound        = "NOT_FOUND"
+	ErrCodeInvalidID       = "INVALID_ID"
+	ErrCodeInvalidFilter   = "INVALID_FILTER"
+	ErrCodeTooManyBodies   = "TOO_MANY_BODIES"
+	ErrCodeBadInput        = "BAD_INPUT"
+	ErrCodeNoActiveProject = "NO_ACTIVE_PROJECT"
+	ErrCodeInternal        = "INTERNAL"
+)
+
+func newError(ctx context.Context, code, message string) *gqlerror.Error {
+	return &gqlerror.Error{
+		Path:    graphql.GetPath(ctx),
+		Message: message,
+		Extensions: map[string]interface{}{
+			"code": code,
+		},
+	}
+}
+
+func notFoundErr(ctx context.Context, message string) error {
+	return newError(ctx, ErrCodeNotFound, message)
+}
+
+// invalidFilterErr returns an error for a filter query with a syntax error,
+// with the position of the error as `position` extension.
+func invalidFilterErr(ctx context.Context, err *filterQueryError) error {
+	gqlErr := newError(ctx, ErrCodeInvalidFilter, fmt.Sprintf("Invalid filter: %v", err))
+	gqlErr.Extensions["position"] = err.Pos
+
+	return gqlErr
+}
+
+// tooManyBodiesErr returns an error for a list query that selects response
+// bodies of more request logs than allowed, with the limit as `limit` extension.
+func tooManyBodiesErr(ctx context.Context, limit int) error {
+	gqlErr := newError(ctx, ErrCodeTooManyBodies, fmt.Sprintf("Response bodies can't be selected for more "+
+		"than %v request logs. Narrow down the filter, or query bodies with `httpRequestLog(id)`.", limit))
+	gqlErr.Extensions["limit"] = limit
+
+	return gqlErr
+}
+
+// ErrorPresenter is a `graphql.ErrorPresenterFunc` that sets the `INTERNAL`
+// error code on errors returned by resolvers that don't have a code already.
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+
+	// Only errors wrapped by gqlgen have an underlying error. Errors without
+	// one (e.g. query validation errors) aren't internal errors.
+	if gqlErr.Unwrap() == nil {
+		return gqlErr
+	}
+
+	if _, ok := gqlErr.Extensions["code"]; !ok {
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = map[string]interface{}{}
+		}
+
+		gqlErr.Extensions["code"] = ErrCodeInternal
+	}
+
+	return gqlErr
+}
+
+// MarshalID marshals an `ID` scalar.
+func MarshalID(id int64) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		io.WriteString(w, strconv.FormatInt(id, 10))
+	})
+}
+
+// UnmarshalID unmarshals an `ID` scalar. Invalid IDs result in an error with
+// the `INVALID_ID` code.
+func UnmarshalID(v interface{}) (int64, error) {
+	id, err := graphql.UnmarshalInt64(v)
+	if err != nil {
+		return 0, &gqlerror.Error{
+			Message: fmt.Sprintf("Invalid ID: %v", v),
+			Extensions: map[string]interface{}{
+				"code": ErrCodeInvalidID,
+			},
+		}
+	}
+
+	return id, nil
+}
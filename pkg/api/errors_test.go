@@ -0,0 +1,78 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestErrorCodes(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+
+	tests := []struct {
+		name    string
+		query   string
+		expCode string
+	}{
+		{
+			name:    "invalid ID",
+			query:   `{ httpRequestLog(id: "foobar") { id } }`,
+			expCode: ErrCodeInvalidID,
+		},
+		{
+			name:    "request log not found",
+			query:   `{ httpRequestLog(id: 42) { id response { statusCode } } }`,
+			expCode: ErrCodeNotFound,
+		},
+		{
+			name:    "saved search not found",
+			query:   `mutation { deleteSearch(id: 42) { success } }`,
+			expCode: ErrCodeNotFound,
+		},
+		{
+			name:    "bad input",
+			query:   `mutation { openProject(name: "foo/bar") { name } }`,
+			expCode: ErrCodeBadInput,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			body, err := json.Marshal(map[string]string{"query": tt.query})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/graphql/", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+
+			var resp struct {
+				Errors []struct {
+					Extensions map[string]interface{}
+				}
+			}
+
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("could not decode response: %v", err)
+			}
+
+			if len(resp.Errors) != 1 {
+				t.Fatalf("expected 1 error, got: %v", len(resp.Errors))
+			}
+
+			if got := resp.Errors[0].Extensions["code"]; got != tt.expCode {
+				t.Errorf("expected error code %q, got: %v", tt.expCode, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// ExportNDJSONHandler returns a handler that serves all request logs matching
+// the active request log filter as a newline delimited JSON download. Responses
+// have an `ETag` header, so pollers can use `If-None-Match` to skip unchanged
+// downloads.
+func ExportNDJSONHandler(svc *reqlog.Service) http.Handler {
+	return exportHandler(svc, "application/x-ndjson", "hetty_logs.ndjson", svc.ExportNDJSON)
+}
+
+// ExportHTTPZipHandler returns a handler that serves all request logs matching
+// the active request log filter as a zip download, with an `.http` file per
+// request log. Like `ExportNDJSONHandler`, responses have an `ETag` header.
+func ExportHTTPZipHandler(svc *reqlog.Service) http.Handler {
+	return exportHandler(svc, "application/zip", "hetty_logs.zip", svc.ExportHTTPZip)
+}
+
+type exportFunc func(ctx context.Context, filter reqlog.FindRequestsFilter, w io.Writer) error
+
+func exportHandler(svc *reqlog.Service, contentType, filename string, export exportFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		filter := svc.FindReqsFilter
+
+		etag, err := svc.ETag(r.Context(), filter)
+		if errors.Is(err, proj.ErrNoProject) {
+			http.Error(w, "No active project.", http.StatusBadRequest)
+			return
+		} else if err != nil {
+			log.Printf("[ERROR] Could not compute ETag of request logs: %v", err)
+			http.Error(w, "Internal server error.", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", "no-cache")
+
+		if etagMatches(r.Header.Get("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%v"`, filename))
+
+		err = export(r.Context(), filter, w)
+		if errors.Is(err, proj.ErrNoProject) {
+			// Nothing was written yet, so an error response can still be sent.
+			w.Header().Del("Content-Disposition")
+			w.Header().Del("ETag")
+			http.Error(w, "No active project.", http.StatusBadRequest)
+			return
+		} else if err != nil {
+			log.Printf("[ERROR] Could not export request logs: %v", err)
+		}
+	})
+}
+
+// etagMatches reports whether an `If-None-Match` header value matches etag,
+// using weak comparison.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
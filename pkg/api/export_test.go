@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestExportNDJSONHandlerETag(t *testing.T) {
+	t.Parallel()
+
+	resolver, db := newTestResolver(t)
+	ctx := context.Background()
+	handler := ExportNDJSONHandler(resolver.RequestLogService)
+
+	addReqLog := func() int64 {
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+		return addTestRequestLog(t, db, reqlog.Request{Request: *req}).ID
+	}
+
+	export := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/export/ndjson/", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	reqID := addReqLog()
+
+	rec := export("")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %v, got: %v", http.StatusOK, rec.Code)
+	}
+
+	etag := rec.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("expected ETag header")
+	}
+
+	rec = export(etag)
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("expected status %v for unchanged request logs, got: %v", http.StatusNotModified, rec.Code)
+	}
+
+	if rec.Body.Len() != 0 {
+		t.Errorf("expected empty body, got: %q", rec.Body.String())
+	}
+
+	rec = export(`"foo", W/` + etag)
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("expected status %v for weak match in list, got: %v", http.StatusNotModified, rec.Code)
+	}
+
+	addReqLog()
+
+	rec = export(etag)
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status %v after insert, got: %v", http.StatusOK, rec.Code)
+	}
+
+	if got := rec.Header().Get("ETag"); got == etag {
+		t.Errorf("expected ETag to change after insert, got: %v", got)
+	}
+
+	etag = rec.Header().Get("ETag")
+
+	if err := db.SetRequestLogStarred(ctx, reqID, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rec = export(etag)
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status %v after update, got: %v", http.StatusOK, rec.Code)
+	}
+
+	if got := rec.Header().Get("ETag"); got == etag {
+		t.Errorf("expected ETag to change after update, got: %v", got)
+	}
+}
+
+func TestExportNDJSONHandlerETagProject(t *testing.T) {
+	t.Parallel()
+
+	resolver, _ := newTestResolver(t)
+	ctx := context.Background()
+	handler := ExportNDJSONHandler(resolver.RequestLogService)
+
+	export := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/export/ndjson/", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	etag := export("").Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("expected ETag header")
+	}
+
+	// An empty project has the same request logs version as the other one.
+	if _, err := resolver.ProjectService.Open(ctx, "other"); err != nil {
+		t.Fatalf("could not open project: %v", err)
+	}
+
+	rec := export(etag)
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status %v after opening another project, got: %v", http.StatusOK, rec.Code)
+	}
+
+	if got := rec.Header().Get("ETag"); got == etag {
+		t.Errorf("expected ETag to change after opening another project, got: %v", got)
+	}
+}
@@ -0,0 +1,281 @@
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+// filterQueryError is a syntax error in a filter query. Pos is the position
+// (1-based, in bytes) of the term or value that's invalid.
+type filterQueryError struct {
+	Pos int
+	Msg string
+}
+
+func (e *filterQueryError) Error() string {
+	return fmt.Sprintf("%v at position %v", e.Msg, e.Pos)
+}
+
+// filterTerm is a term of a filter query, e.g. `status:>=500`. Terms without a
+// (known) key only have a value. valuePos is the position of the value.
+type filterTerm struct {
+	key      string
+	value    string
+	valuePos int
+}
+
+// parseFilterQuery parses a filter query into a request log filter, e.g.
+// `method:POST host:example.com status:>=500 body:"password"`. Terms are
+// separated by whitespace, and all terms must match. Values can be quoted with
+// double quotes, which can be escaped with a backslash. Terms without a known
+// key match request or response bodies that contain them.
+//
+// Supported keys are `method`, `host`, `status` (with an optional `=`, `!=`,
+// `>`, `>=`, `<` or `<=` operator), `body`, `path` (a path prefix), `query` (a
+// substring of the raw query), `proto`, `scope` (`in` or `out`), and `unread`
+// and `starred` (`true` or `false`).
+func parseFilterQuery(query string) (reqlog.FindRequestsFilter, error) {
+	var (
+		filter reqlog.FindRequestsFilter
+		exprs  []search.Expression
+	)
+
+	terms, err := lexFilterQuery(query)
+	if err != nil {
+		return reqlog.FindRequestsFilter{}, err
+	}
+
+	for _, term := range terms {
+		if term.key != "" && term.value == "" {
+			return reqlog.FindRequestsFilter{}, &filterQueryError{
+				Pos: term.valuePos,
+				Msg: fmt.Sprintf("missing value for %q", term.key),
+			}
+		}
+
+		switch term.key {
+		case "method":
+			exprs = append(exprs, infixExpr(search.TokOpEq, "req.method", strings.ToUpper(term.value)))
+		case "host":
+			// Stored URLs are absolute, so the host follows the scheme.
+			pattern := `(?i)^[a-z]+://` + regexp.QuoteMeta(term.value) + `(:[0-9]+)?([/?#]|$)`
+			exprs = append(exprs, infixExpr(search.TokOpRe, "req.url", pattern))
+		case "status":
+			expr, err := statusExpr(term)
+			if err != nil {
+				return reqlog.FindRequestsFilter{}, err
+			}
+
+			exprs = append(exprs, expr)
+		case "path":
+			if !strings.HasPrefix(term.value, "/") {
+				return reqlog.FindRequestsFilter{}, &filterQueryError{Pos: term.valuePos, Msg: "path must start with \"/\""}
+			}
+
+			filter.PathPrefix = term.value
+		case "query":
+			filter.QueryContains = term.value
+		case "proto":
+			filter.Protos = append(filter.Protos, term.value)
+		case "scope":
+			var inScope bool
+
+			switch strings.ToLower(term.value) {
+			case "in":
+				inScope = true
+			case "out":
+			default:
+				return reqlog.FindRequestsFilter{}, &filterQueryError{
+					Pos: term.valuePos,
+					Msg: fmt.Sprintf("invalid scope %q, expected \"in\" or \"out\"", term.value),
+				}
+			}
+
+			filter.InScope = &inScope
+		case "unread":
+			unread, err := strconv.ParseBool(term.value)
+			if err != nil {
+				return reqlog.FindRequestsFilter{}, &filterQueryError{
+					Pos: term.valuePos,
+					Msg: fmt.Sprintf("invalid boolean %q", term.value),
+				}
+			}
+
+			filter.Unread = &unread
+		case "starred":
+			starred, err := strconv.ParseBool(term.value)
+			if err != nil {
+				return reqlog.FindRequestsFilter{}, &filterQueryError{
+					Pos: term.valuePos,
+					Msg: fmt.Sprintf("invalid boolean %q", term.value),
+				}
+			}
+
+			filter.Starred = &starred
+		default:
+			// Includes `body`, and terms without a known key.
+			exprs = append(exprs, &search.InfixExpression{
+				Operator: search.TokOpOr,
+				Left:     infixExpr(search.TokOpRe, "req.body", regexp.QuoteMeta(term.value)),
+				Right:    infixExpr(search.TokOpRe, "res.body", regexp.QuoteMeta(term.value)),
+			})
+		}
+	}
+
+	for _, expr := range exprs {
+		if filter.SearchExpr == nil {
+			filter.SearchExpr = expr
+			continue
+		}
+
+		filter.SearchExpr = &search.InfixExpression{
+			Operator: search.TokOpAnd,
+			Left:     filter.SearchExpr,
+			Right:    expr,
+		}
+	}
+
+	return filter, nil
+}
+
+var filterQueryKeys = map[string]bool{
+	"method":  true,
+	"host":    true,
+	"status":  true,
+	"body":    true,
+	"path":    true,
+	"query":   true,
+	"proto":   true,
+	"scope":   true,
+	"unread":  true,
+	"starred": true,
+}
+
+// lexFilterQuery splits a filter query into terms. Unknown keys are kept as
+// part of the value, so e.g. `foo:bar` is a term with value "foo:bar".
+func lexFilterQuery(query string) ([]filterTerm, error) {
+	var terms []filterTerm
+
+	i := 0
+
+	for {
+		for i < len(query) && isFilterSpace(query[i]) {
+			i++
+		}
+
+		if i == len(query) {
+			return terms, nil
+		}
+
+		var term filterTerm
+
+		if colon := strings.IndexByte(query[i:], ':'); colon > 0 {
+			key := strings.ToLower(query[i : i+colon])
+			if filterQueryKeys[key] {
+				term.key = key
+				i += colon + 1
+			}
+		}
+
+		term.valuePos = i + 1
+
+		value, n, err := lexFilterValue(query[i:])
+		if err != nil {
+			err.Pos += i
+			return nil, err
+		}
+
+		term.value = value
+		i += n
+
+		terms = append(terms, term)
+	}
+}
+
+// lexFilterValue returns the (unquoted) value at the start of s, and the number
+// of bytes it took up.
+func lexFilterValue(s string) (string, int, *filterQueryError) {
+	if !strings.HasPrefix(s, `"`) {
+		n := 0
+		for n < len(s) && !isFilterSpace(s[n]) {
+			n++
+		}
+
+		return s[:n], n, nil
+	}
+
+	var b strings.Builder
+
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 == len(s) {
+				return "", 0, &filterQueryError{Pos: i + 1, Msg: "unterminated escape sequence"}
+			}
+
+			i++
+			b.WriteByte(s[i])
+		case '"':
+			if i+1 < len(s) && !isFilterSpace(s[i+1]) {
+				return "", 0, &filterQueryError{Pos: i + 2, Msg: "expected whitespace after quoted value"}
+			}
+
+			return b.String(), i + 1, nil
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return "", 0, &filterQueryError{Pos: 1, Msg: "unterminated quoted value"}
+}
+
+func isFilterSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+var statusOperators = []struct {
+	prefix string
+	op     search.TokenType
+}{
+	// Longer operators first, so that e.g. `>=` isn't parsed as `>`.
+	{prefix: ">=", op: search.TokOpGtEq},
+	{prefix: "<=", op: search.TokOpLtEq},
+	{prefix: "!=", op: search.TokOpNotEq},
+	{prefix: ">", op: search.TokOpGt},
+	{prefix: "<", op: search.TokOpLt},
+	{prefix: "=", op: search.TokOpEq},
+}
+
+func statusExpr(term filterTerm) (search.Expression, error) {
+	value, op := term.value, search.TokOpEq
+	codePos := term.valuePos
+
+	for _, o := range statusOperators {
+		if strings.HasPrefix(value, o.prefix) {
+			value, op = value[len(o.prefix):], o.op
+			codePos += len(o.prefix)
+
+			break
+		}
+	}
+
+	code, err := strconv.Atoi(value)
+	if err != nil || code < 100 || code > 999 {
+		return nil, &filterQueryError{Pos: codePos, Msg: fmt.Sprintf("invalid status code %q", value)}
+	}
+
+	return infixExpr(op, "res.statusCode", strconv.Itoa(code)), nil
+}
+
+func infixExpr(op search.TokenType, field, value string) *search.InfixExpression {
+	return &search.InfixExpression{
+		Operator: op,
+		Left:     &search.StringLiteral{Value: field},
+		Right:    &search.StringLiteral{Value: value},
+	}
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+func TestParseFilterQuery(t *testing.T) {
+	t.Parallel()
+
+	got, err := parseFilterQuery(`method:post host:example.com status:>=500 body:"pass word" ` +
+		`path:/api unread:true starred:false token`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	bodyExpr := func(value string) search.Expression {
+		return &search.InfixExpression{
+			Operator: search.TokOpOr,
+			Left:     infixExpr(search.TokOpRe, "req.body", value),
+			Right:    infixExpr(search.TokOpRe, "res.body", value),
+		}
+	}
+
+	and := func(left, right search.Expression) search.Expression {
+		return &search.InfixExpression{Operator: search.TokOpAnd, Left: left, Right: right}
+	}
+
+	unread, starred := true, false
+	exp := reqlog.FindRequestsFilter{
+		SearchExpr: and(and(and(and(
+			infixExpr(search.TokOpEq, "req.method", "POST"),
+			infixExpr(search.TokOpRe, "req.url", `(?i)^[a-z]+://example\.com(:[0-9]+)?([/?#]|$)`)),
+			infixExpr(search.TokOpGtEq, "res.statusCode", "500")),
+			bodyExpr("pass word")),
+			bodyExpr("token")),
+		PathPrefix: "/api",
+		Unread:     &unread,
+		Starred:    &starred,
+	}
+
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf("expected filter:\n%+v (%v)\ngot:\n%+v (%v)", exp, exp.SearchExpr, got, got.SearchExpr)
+	}
+}
+
+func TestParseFilterQueryErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query  string
+		expPos int
+	}{
+		{query: `status:abc`, expPos: 8},
+		{query: `method:GET status:>=5000`, expPos: 21},
+		{query: `method:`, expPos: 8},
+		{query: `body:"unterminated`, expPos: 6},
+		{query: `body:"foo"bar`, expPos: 11},
+		{query: `scope:sideways`, expPos: 7},
+		{query: `path:api`, expPos: 6},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.query, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseFilterQuery(tt.query)
+
+			var queryErr *filterQueryError
+			if !errors.As(err, &queryErr) {
+				t.Fatalf("expected filter query error, got: %v", err)
+			}
+
+			if queryErr.Pos != tt.expPos {
+				t.Errorf("expected error at position %v, got: %v", tt.expPos, err)
+			}
+		})
+	}
+}
+
+func TestHTTPRequestLogsFilterQuery(t *testing.T) {
+	t.Parallel()
+
+	resolver, db := newTestResolver(t)
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		method     string
+		url        string
+		body       string
+		statusCode int
+	}{
+		{method: http.MethodPost, url: "https://example.com/login", body: "password=foo", statusCode: 500},
+		{method: http.MethodPost, url: "https://example.com:8443/login", body: "password=foo", statusCode: 200},
+		{method: http.MethodGet, url: "https://example.com.evil.com/", statusCode: 503},
+	} {
+		res := http.Response{StatusCode: tt.statusCode, Proto: "HTTP/1.1", Header: http.Header{}}
+
+		addTestRequestLog(t, db, reqlog.Request{
+			Request:  *httptest.NewRequest(tt.method, tt.url, nil),
+			Body:     []byte(tt.body),
+			Response: &reqlog.Response{Response: res},
+		})
+	}
+
+	tests := []struct {
+		filter  string
+		expURLs []string
+	}{
+		{filter: `method:POST host:example.com status:>=500 body:"password"`, expURLs: []string{"https://example.com/login"}},
+		{filter: `host:example.com`, expURLs: []string{"https://example.com/login", "https://example.com:8443/login"}},
+		{filter: `status:>=500`, expURLs: []string{"https://example.com/login", "https://example.com.evil.com/"}},
+		{filter: `password`, expURLs: []string{"https://example.com/login", "https://example.com:8443/login"}},
+	}
+
+	for _, tt := range tests {
+		filter := tt.filter
+
+		logs, err := resolver.Query().HTTPRequestLogs(ctx, nil, &filter)
+		if err != nil {
+			t.Fatalf("unexpected error for filter %q: %v", tt.filter, err)
+		}
+
+		var urls []string
+		for _, log := range logs {
+			urls = append(urls, log.URL)
+		}
+
+		if len(urls) != len(tt.expURLs) || !sameElements(urls, tt.expURLs) {
+			t.Errorf("filter %q: expected request logs %v, got: %v", tt.filter, tt.expURLs, urls)
+		}
+	}
+}
+
+func sameElements(a, b []string) bool {
+	counts := make(map[string]int)
+	for _, s := range a {
+		counts[s]++
+	}
+
+	for _, s := range b {
+		counts[s]--
+	}
+
+	for _, n := range counts {
+		if n != 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const headerCursorPrefix = "header:"
+
+func (r *httpRequestLogResolver) HeadersConnection(
+	ctx context.Context,
+	obj *HTTPRequestLog,
+	first *int,
+	after *string,
+) (*HTTPHeaderConnection, error) {
+	return headersConnection(ctx, obj.Headers, first, after)
+}
+
+func (r *httpResponseLogResolver) HeadersConnection(
+	ctx context.Context,
+	obj *HTTPResponseLog,
+	first *int,
+	after *string,
+) (*HTTPHeaderConnection, error) {
+	return headersConnection(ctx, obj.Headers, first, after)
+}
+
+// headersConnection returns a page of headers. Headers are sorted by key first,
+// because their order is lost when they're parsed, and pages must be stable.
+// Values of the same key keep their order. Cursors are the (encoded) index of a
+// header in the sorted headers.
+func headersConnection(ctx context.Context, headers []HTTPHeader, first *int, after *string) (*HTTPHeaderConnection, error) {
+	sorted := make([]HTTPHeader, len(headers))
+	copy(sorted, headers)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Key < sorted[j].Key })
+
+	start := 0
+
+	if after != nil {
+		i, ok := decodeHeaderCursor(*after)
+		if !ok {
+			return nil, newError(ctx, ErrCodeBadInput, fmt.Sprintf("Invalid cursor: %q", *after))
+		}
+
+		start = i + 1
+	}
+
+	if start > len(sorted) {
+		start = len(sorted)
+	}
+
+	end := len(sorted)
+
+	if first != nil {
+		if *first < 0 {
+			return nil, newError(ctx, ErrCodeBadInput, "Argument `first` must not be negative.")
+		}
+
+		if start+*first < end {
+			end = start + *first
+		}
+	}
+
+	conn := &HTTPHeaderConnection{
+		Edges:      make([]HTTPHeaderEdge, 0, end-start),
+		PageInfo:   &PageInfo{HasNextPage: end < len(sorted)},
+		TotalCount: len(sorted),
+	}
+
+	for i := start; i < end; i++ {
+		conn.Edges = append(conn.Edges, HTTPHeaderEdge{
+			Cursor: encodeHeaderCursor(i),
+			Node:   &sorted[i],
+		})
+	}
+
+	if len(conn.Edges) > 0 {
+		endCursor := conn.Edges[len(conn.Edges)-1].Cursor
+		conn.PageInfo.EndCursor = &endCursor
+	}
+
+	return conn, nil
+}
+
+func encodeHeaderCursor(i int) string {
+	return base64.StdEncoding.EncodeToString([]byte(headerCursorPrefix + strconv.Itoa(i)))
+}
+
+func decodeHeaderCursor(cursor string) (int, bool) {
+	b, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(b), headerCursorPrefix) {
+		return 0, false
+	}
+
+	i, err := strconv.Atoi(strings.TrimPrefix(string(b), headerCursorPrefix))
+	if err != nil || i < 0 {
+		return 0, false
+	}
+
+	return i, true
+}
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+)
+
+const healthCheckTimeout = 2 * time.Second
+
+// Pinger is implemented by repositories that can verify their database
+// connection.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// HealthHandler returns a handler for readiness checks. It responds with
+// `200 OK` if the database responds to a ping in time, and with `503 Service
+// Unavailable` otherwise. Having no active project isn't considered unhealthy,
+// because requests are proxied regardless.
+func HealthHandler(pinger Pinger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+		defer cancel()
+
+		if err := pinger.Ping(ctx); err != nil && !errors.Is(err, proj.ErrNoProject) {
+			log.Printf("[ERROR] Health check failed: %v", err)
+			http.Error(w, "Service unavailable.", http.StatusServiceUnavailable)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write([]byte("OK\n"))
+	})
+}
@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type pingerFunc func(ctx context.Context) error
+
+func (fn pingerFunc) Ping(ctx context.Context) error {
+	return fn(ctx)
+}
+
+func TestHealthHandler(t *testing.T) {
+	t.Parallel()
+
+	_, openDB := newTestResolver(t)
+
+	closedDB, err := sqlx.Open("sqlite3", filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := closedDB.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		pinger    Pinger
+		expStatus int
+	}{
+		{
+			name:      "open database",
+			pinger:    openDB,
+			expStatus: http.StatusOK,
+		},
+		{
+			name:      "closed database",
+			pinger:    pingerFunc(closedDB.PingContext),
+			expStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			HealthHandler(tt.pinger).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+			if rec.Code != tt.expStatus {
+				t.Errorf("expected status %v, got: %v", tt.expStatus, rec.Code)
+			}
+		})
+	}
+}
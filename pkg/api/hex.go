@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+)
+
+const (
+	defaultHexDumpLimit = 4096
+	maxHexDumpLimit     = 65536
+)
+
+func (r *httpRequestLogResolver) BodyHex(ctx context.Context, obj *HTTPRequestLog, limit *int) (*string, error) {
+	return hexDump(obj.Body, limit), nil
+}
+
+func (r *httpResponseLogResolver) BodyHex(ctx context.Context, obj *HTTPResponseLog, limit *int) (*string, error) {
+	return hexDump(obj.Body, limit), nil
+}
+
+// hexDump returns a hex dump of the first limit bytes of body, or nil if there's
+// no body. The limit defaults to 4096 if it's nil or not positive, and is capped
+// at 65536.
+func hexDump(body *string, limit *int) *string {
+	if body == nil {
+		return nil
+	}
+
+	n := defaultHexDumpLimit
+	if limit != nil && *limit > 0 {
+		n = *limit
+	}
+
+	if n > maxHexDumpLimit {
+		n = maxHexDumpLimit
+	}
+
+	b := []byte(*body)
+	if len(b) > n {
+		b = b[:n]
+	}
+
+	dump := hex.Dump(b)
+
+	return &dump
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+)
+
+func (r *queryResolver) TopHosts(ctx context.Context, limit *int, input *HTTPRequestLogFilterInput) ([]HostCount, error) {
+	filter, err := findRequestsFilterFromInput(input)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse request log filter: %w", err)
+	}
+
+	var n int
+	if limit != nil {
+		n = *limit
+	}
+
+	hostCounts, err := r.RequestLogService.TopHosts(ctx, filter, n)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get top hosts: %w", err)
+	}
+
+	result := make([]HostCount, len(hostCounts))
+	for i, hostCount := range hostCounts {
+		result[i] = HostCount{
+			Host:  hostCount.Host,
+			Count: int(hostCount.Count),
+		}
+	}
+
+	return result, nil
+}
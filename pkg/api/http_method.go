@@ -0,0 +1,30 @@
+package api
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// HTTPMethod is the `HttpMethod` scalar. Methods aren't restricted to the
+// standard ones, so non-standard methods (e.g. WebDAV's `PROPFIND`, or garbage
+// sent while fuzzing) are passed through as-is.
+type HTTPMethod string
+
+// MarshalGQL implements graphql.Marshaler.
+func (m HTTPMethod) MarshalGQL(w io.Writer) {
+	graphql.MarshalString(string(m)).MarshalGQL(w)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+func (m *HTTPMethod) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("HTTP method must be a string, got: %T", v)
+	}
+
+	*m = HTTPMethod(s)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestHTTPRequestLogsNonStandardMethods(t *testing.T) {
+	t.Parallel()
+
+	resolver, db := newTestResolver(t)
+	methods := []string{http.MethodGet, "PROPFIND", "G\"E\\T\x01"}
+
+	for _, method := range methods {
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+		req.Method = method
+
+		addTestRequestLog(t, db, reqlog.Request{Request: *req})
+	}
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver}))
+
+	body := []byte(`{"query": "{ httpRequestLogs { method response { statusCode } } }"}`)
+	req := httptest.NewRequest(http.MethodPost, "/api/graphql/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+
+	var resp struct {
+		Data struct {
+			HTTPRequestLogs []struct {
+				Method string
+			}
+		}
+		Errors []interface{}
+	}
+
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+
+	if len(resp.Errors) != 0 {
+		t.Fatalf("unexpected errors: %v", resp.Errors)
+	}
+
+	got := make([]string, len(resp.Data.HTTPRequestLogs))
+	for i, reqLog := range resp.Data.HTTPRequestLogs {
+		got[i] = reqLog.Method
+	}
+
+	// Request logs are ordered by ID, descending.
+	exp := []string{methods[2], methods[1], methods[0]}
+	if !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected methods %q, got: %q", exp, got)
+	}
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func (r *queryResolver) HTTPRequestLogIntegrity(ctx context.Context) (*HTTPRequestLogIntegrity, error) {
+	report, err := r.RequestLogService.CheckIntegrity(ctx)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not check integrity of request logs: %w", err)
+	}
+
+	return parseIntegrityReport(report), nil
+}
+
+func (r *mutationResolver) RepairHTTPRequestLogIntegrity(ctx context.Context) (*HTTPRequestLogIntegrity, error) {
+	report, err := r.RequestLogService.RepairIntegrity(ctx)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not repair integrity of request logs: %w", err)
+	}
+
+	return parseIntegrityReport(report), nil
+}
+
+func (r *mutationResolver) PruneOrphanHTTPHeaders(ctx context.Context) (*PruneOrphanHTTPHeadersResult, error) {
+	n, err := r.RequestLogService.PruneOrphanHeaders(ctx)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not prune orphan headers: %w", err)
+	}
+
+	return &PruneOrphanHTTPHeadersResult{
+		DeletedCount: int(n),
+	}, nil
+}
+
+func parseIntegrityReport(report reqlog.IntegrityReport) *HTTPRequestLogIntegrity {
+	return &HTTPRequestLogIntegrity{
+		OrphanResponses: int(report.OrphanResponses),
+		OrphanHeaders:   int(report.OrphanHeaders),
+	}
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+)
+
+func (r *httpRequestLogStatsResolver) LatencyStats(
+	ctx context.Context,
+	obj *HTTPRequestLogStats,
+	input *HTTPRequestLogFilterInput,
+) (*LatencyStats, error) {
+	filter, err := findRequestsFilterFromInput(input)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse request log filter: %w", err)
+	}
+
+	stats, ok, err := r.RequestLogService.LatencyPercentiles(ctx, filter)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get latency stats: %w", err)
+	}
+
+	if !ok {
+		return nil, nil
+	}
+
+	return &LatencyStats{
+		P50: durationToMilliseconds(stats.P50),
+		P95: durationToMilliseconds(stats.P95),
+		P99: durationToMilliseconds(stats.P99),
+		Max: durationToMilliseconds(stats.Max),
+	}, nil
+}
+
+func durationToMilliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func durationPtrToMilliseconds(d *time.Duration) *float64 {
+	if d == nil {
+		return nil
+	}
+
+	ms := durationToMilliseconds(*d)
+
+	return &ms
+}
@@ -0,0 +1,28 @@
+package api
+
+import "github.com/dstotijn/hetty/pkg/reqlog"
+
+const maxMatchRanges = 100
+
+// setBodyMatches sets the ranges of the request and response bodies of log
+// that match the search expression of filter.
+func setBodyMatches(log *HTTPRequestLog, filter reqlog.FindRequestsFilter) {
+	if log.Body != nil {
+		log.Matches = matchRanges(filter, "req.body", *log.Body)
+	}
+
+	if log.Response != nil && log.Response.Body != nil {
+		log.Response.Matches = matchRanges(filter, "res.body", *log.Response.Body)
+	}
+}
+
+func matchRanges(filter reqlog.FindRequestsFilter, field, body string) []MatchRange {
+	matches := reqlog.BodyMatches(filter.SearchExpr, field, []byte(body), filter.CaseInsensitive, maxMatchRanges)
+	ranges := make([]MatchRange, len(matches))
+
+	for i, match := range matches {
+		ranges[i] = MatchRange{Start: match.Start, Length: match.Length}
+	}
+
+	return ranges
+}
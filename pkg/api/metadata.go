@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func (r *httpRequestLogResolver) Metadata(ctx context.Context, obj *HTTPRequestLog) ([]HTTPRequestLogMetadata, error) {
+	metadata, err := r.RequestLogService.GetMetadata(ctx, obj.ID)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get request log metadata: %w", err)
+	}
+
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	result := make([]HTTPRequestLogMetadata, len(keys))
+	for i, key := range keys {
+		result[i] = HTTPRequestLogMetadata{
+			Key:   key,
+			Value: string(metadata[key]),
+		}
+	}
+
+	return result, nil
+}
+
+func (r *mutationResolver) SetHTTPRequestLogMetadata(
+	ctx context.Context,
+	requestID int64,
+	key string,
+	value string,
+) (*HTTPRequestLogMetadata, error) {
+	err := r.RequestLogService.SetMetadata(ctx, requestID, key, json.RawMessage(value))
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, reqlog.ErrRequestNotFound):
+		return nil, notFoundErr(ctx, "Request log not found.")
+	case errors.Is(err, reqlog.ErrInvalidMetadata):
+		return nil, newError(ctx, ErrCodeBadInput, fmt.Sprintf("Invalid metadata: %v", err))
+	case err != nil:
+		return nil, fmt.Errorf("could not set request log metadata: %w", err)
+	}
+
+	return &HTTPRequestLogMetadata{
+		Key:   key,
+		Value: value,
+	}, nil
+}
@@ -9,6 +9,17 @@ import (
 	"time"
 )
 
+type AuditTimestamps struct {
+	CreatedAt *time.Time `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+type BodyHashCount struct {
+	Hash         string `json:"hash"`
+	Count        int    `json:"count"`
+	RequestCount int    `json:"requestCount"`
+}
+
 type ClearHTTPRequestLogResult struct {
 	Success bool `json:"success"`
 }
@@ -17,43 +28,272 @@ type CloseProjectResult struct {
 	Success bool `json:"success"`
 }
 
+type CreateHTTPRequestLogInput struct {
+	Method  HTTPMethod        `json:"method"`
+	URL     string            `json:"url"`
+	Proto   *string           `json:"proto"`
+	Headers []HTTPHeaderInput `json:"headers"`
+	Body    *string           `json:"body"`
+}
+
+type DeleteDuplicateHTTPRequestLogsResult struct {
+	DeletedCount int `json:"deletedCount"`
+}
+
 type DeleteProjectResult struct {
 	Success bool `json:"success"`
 }
 
+type DeleteSavedSearchResult struct {
+	Success bool `json:"success"`
+}
+
+type HeaderMatch struct {
+	Key   string  `json:"key"`
+	Value *string `json:"value"`
+}
+
+type HeaderMatchInput struct {
+	Key   string  `json:"key"`
+	Value *string `json:"value"`
+}
+
+type HostCount struct {
+	Host  string `json:"host"`
+	Count int    `json:"count"`
+}
+
+type HTTPBaselineComparison struct {
+	StatusChanged      bool               `json:"statusChanged"`
+	StatusCode         int                `json:"statusCode"`
+	BaselineStatusCode int                `json:"baselineStatusCode"`
+	BodySizeDelta      int                `json:"bodySizeDelta"`
+	BodySize           int                `json:"bodySize"`
+	BaselineBodySize   int                `json:"baselineBodySize"`
+	BodyChanged        bool               `json:"bodyChanged"`
+	Headers            []HTTPHeaderDiff   `json:"headers"`
+	BodyDiff           []HTTPBodyLineDiff `json:"bodyDiff"`
+	BodyDiffTruncated  bool               `json:"bodyDiffTruncated"`
+}
+
+type HTTPBodyLineDiff struct {
+	Op   DiffOp `json:"op"`
+	Line int    `json:"line"`
+	Text string `json:"text"`
+}
+
 type HTTPHeader struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
 }
 
+type HTTPHeaderConnection struct {
+	Edges      []HTTPHeaderEdge `json:"edges"`
+	PageInfo   *PageInfo        `json:"pageInfo"`
+	TotalCount int              `json:"totalCount"`
+}
+
+type HTTPHeaderDiff struct {
+	Key            string   `json:"key"`
+	Values         []string `json:"values"`
+	BaselineValues []string `json:"baselineValues"`
+}
+
+type HTTPHeaderEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   *HTTPHeader `json:"node"`
+}
+
+type HTTPHeaderInput struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 type HTTPRequestLog struct {
-	ID        int64            `json:"id"`
-	URL       string           `json:"url"`
-	Method    HTTPMethod       `json:"method"`
-	Proto     string           `json:"proto"`
-	Headers   []HTTPHeader     `json:"headers"`
-	Body      *string          `json:"body"`
-	Timestamp time.Time        `json:"timestamp"`
-	Response  *HTTPResponseLog `json:"response"`
+	ID                int64                    `json:"id"`
+	URL               string                   `json:"url"`
+	HostHeader        *string                  `json:"hostHeader"`
+	Method            HTTPMethod               `json:"method"`
+	Proto             string                   `json:"proto"`
+	Headers           []HTTPHeader             `json:"headers"`
+	HeadersConnection *HTTPHeaderConnection    `json:"headersConnection"`
+	Body              *string                  `json:"body"`
+	BodyDecoded       bool                     `json:"bodyDecoded"`
+	BodyProtobuf      bool                     `json:"bodyProtobuf"`
+	BodyHex           *string                  `json:"bodyHex"`
+	BodyType          *string                  `json:"bodyType"`
+	Matches           []MatchRange             `json:"matches"`
+	AsPython          *string                  `json:"asPython"`
+	Timestamp         time.Time                `json:"timestamp"`
+	RelativeTime      string                   `json:"relativeTime"`
+	FormattedTime     string                   `json:"formattedTime"`
+	TLSVersion        *string                  `json:"tlsVersion"`
+	TLSCipher         *string                  `json:"tlsCipher"`
+	Fingerprint       *string                  `json:"fingerprint"`
+	CanonicalURL      *string                  `json:"canonicalUrl"`
+	IsBaseline        bool                     `json:"isBaseline"`
+	Starred           bool                     `json:"starred"`
+	Referer           *string                  `json:"referer"`
+	ServerAddr        *string                  `json:"serverAddr"`
+	ClientCert        bool                     `json:"clientCert"`
+	ConnectionID      *int64                   `json:"connectionId"`
+	RedirectedFromID  *int64                   `json:"redirectedFromId"`
+	RedirectChain     []HTTPRequestLog         `json:"redirectChain"`
+	Listener          *string                  `json:"listener"`
+	GrpcMethod        *string                  `json:"grpcMethod"`
+	Sni               *string                  `json:"sni"`
+	BodyHash          *string                  `json:"bodyHash"`
+	Unread            bool                     `json:"unread"`
+	HeadersTruncated  bool                     `json:"headersTruncated"`
+	HeadersError      *string                  `json:"headersError"`
+	BodyTruncated     bool                     `json:"bodyTruncated"`
+	BodyLineCount     *int                     `json:"bodyLineCount"`
+	RequestBodySize   int                      `json:"requestBodySize"`
+	ResponseBodySize  *int                     `json:"responseBodySize"`
+	TransferSize      int                      `json:"transferSize"`
+	Metadata          []HTTPRequestLogMetadata `json:"metadata"`
+	Tags              []Tag                    `json:"tags"`
+	Response          *HTTPResponseLog         `json:"response"`
+}
+
+type HTTPRequestLogDuplicates struct {
+	Fingerprint string  `json:"fingerprint"`
+	RequestIds  []int64 `json:"requestIds"`
 }
 
 type HTTPRequestLogFilter struct {
-	OnlyInScope      bool    `json:"onlyInScope"`
-	SearchExpression *string `json:"searchExpression"`
+	OnlyInScope         bool           `json:"onlyInScope"`
+	SearchExpression    *string        `json:"searchExpression"`
+	PathPrefix          *string        `json:"pathPrefix"`
+	QueryContains       *string        `json:"queryContains"`
+	CaseInsensitive     bool           `json:"caseInsensitive"`
+	RequestContentType  *string        `json:"requestContentType"`
+	ResponseContentType *string        `json:"responseContentType"`
+	Protos              []string       `json:"protos"`
+	ResponseSize        *IntRange      `json:"responseSize"`
+	JSONPathMatch       *JSONPathMatch `json:"jsonPathMatch"`
+	InScope             *bool          `json:"inScope"`
+	RequestHeaders      []HeaderMatch  `json:"requestHeaders"`
+	ResponseHeaders     []HeaderMatch  `json:"responseHeaders"`
+	ConnectionID        *int64         `json:"connectionId"`
+	Unread              *bool          `json:"unread"`
+	CacheStatus         *CacheStatus   `json:"cacheStatus"`
+	Sni                 *string        `json:"sni"`
+	Listener            *string        `json:"listener"`
+	StatusClass         []StatusClass  `json:"statusClass"`
+	Starred             *bool          `json:"starred"`
 }
 
 type HTTPRequestLogFilterInput struct {
-	OnlyInScope      *bool   `json:"onlyInScope"`
-	SearchExpression *string `json:"searchExpression"`
+	OnlyInScope         *bool               `json:"onlyInScope"`
+	SearchExpression    *string             `json:"searchExpression"`
+	PathPrefix          *string             `json:"pathPrefix"`
+	QueryContains       *string             `json:"queryContains"`
+	CaseInsensitive     *bool               `json:"caseInsensitive"`
+	RequestContentType  *string             `json:"requestContentType"`
+	ResponseContentType *string             `json:"responseContentType"`
+	Protos              []string            `json:"protos"`
+	ResponseSize        *IntRangeInput      `json:"responseSize"`
+	JSONPathMatch       *JSONPathMatchInput `json:"jsonPathMatch"`
+	InScope             *bool               `json:"inScope"`
+	RequestHeaders      []HeaderMatchInput  `json:"requestHeaders"`
+	ResponseHeaders     []HeaderMatchInput  `json:"responseHeaders"`
+	ConnectionID        *int64              `json:"connectionId"`
+	Unread              *bool               `json:"unread"`
+	CacheStatus         *CacheStatus        `json:"cacheStatus"`
+	Sni                 *string             `json:"sni"`
+	Listener            *string             `json:"listener"`
+	StatusClass         []StatusClass       `json:"statusClass"`
+	Starred             *bool               `json:"starred"`
+}
+
+type HTTPRequestLogIntegrity struct {
+	OrphanResponses int `json:"orphanResponses"`
+	OrphanHeaders   int `json:"orphanHeaders"`
+}
+
+type HTTPRequestLogMetadata struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type HTTPRequestLogRaw struct {
+	RequestRaw  string  `json:"requestRaw"`
+	ResponseRaw *string `json:"responseRaw"`
+}
+
+type HTTPRequestLogStats struct {
+	DuplicateCount int           `json:"duplicateCount"`
+	UnreadCount    int           `json:"unreadCount"`
+	LatencyStats   *LatencyStats `json:"latencyStats"`
 }
 
 type HTTPResponseLog struct {
-	RequestID    int64        `json:"requestId"`
-	Proto        string       `json:"proto"`
-	StatusCode   int          `json:"statusCode"`
-	StatusReason string       `json:"statusReason"`
-	Body         *string      `json:"body"`
-	Headers      []HTTPHeader `json:"headers"`
+	RequestID             int64                 `json:"requestId"`
+	Proto                 string                `json:"proto"`
+	StatusCode            int                   `json:"statusCode"`
+	StatusReason          string                `json:"statusReason"`
+	Body                  *string               `json:"body"`
+	BodyDecoded           bool                  `json:"bodyDecoded"`
+	BodyProtobuf          bool                  `json:"bodyProtobuf"`
+	BodyHex               *string               `json:"bodyHex"`
+	BodyType              *string               `json:"bodyType"`
+	Matches               []MatchRange          `json:"matches"`
+	ContentLengthMismatch bool                  `json:"contentLengthMismatch"`
+	BodySkipped           bool                  `json:"bodySkipped"`
+	HeadersTruncated      bool                  `json:"headersTruncated"`
+	HeadersError          *string               `json:"headersError"`
+	BodyTruncated         bool                  `json:"bodyTruncated"`
+	BodyLineCount         *int                  `json:"bodyLineCount"`
+	Headers               []HTTPHeader          `json:"headers"`
+	HeadersConnection     *HTTPHeaderConnection `json:"headersConnection"`
+	Timings               *HTTPTimings          `json:"timings"`
+	CacheStatus           CacheStatus           `json:"cacheStatus"`
+	BodyHash              *string               `json:"bodyHash"`
+}
+
+type HTTPTimings struct {
+	DNSMs     *float64 `json:"dnsMs"`
+	ConnectMs *float64 `json:"connectMs"`
+	TLSMs     *float64 `json:"tlsMs"`
+	TtfbMs    *float64 `json:"ttfbMs"`
+}
+
+type IntRange struct {
+	Min *int `json:"min"`
+	Max *int `json:"max"`
+}
+
+type IntRangeInput struct {
+	Min *int `json:"min"`
+	Max *int `json:"max"`
+}
+
+type JSONPathMatch struct {
+	Path  string `json:"path"`
+	Value string `json:"value"`
+}
+
+type JSONPathMatchInput struct {
+	Path  string `json:"path"`
+	Value string `json:"value"`
+}
+
+type LatencyStats struct {
+	P50 float64 `json:"p50"`
+	P95 float64 `json:"p95"`
+	P99 float64 `json:"p99"`
+	Max float64 `json:"max"`
+}
+
+type MatchRange struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor"`
 }
 
 type Project struct {
@@ -61,6 +301,33 @@ type Project struct {
 	IsActive bool   `json:"isActive"`
 }
 
+type PruneOrphanHTTPHeadersResult struct {
+	DeletedCount int `json:"deletedCount"`
+}
+
+type ReplaySummary struct {
+	Count        int      `json:"count"`
+	SuccessCount int      `json:"successCount"`
+	ErrorCount   int      `json:"errorCount"`
+	MinLatency   *float64 `json:"minLatency"`
+	MaxLatency   *float64 `json:"maxLatency"`
+	AvgLatency   *float64 `json:"avgLatency"`
+}
+
+type ResendOptionsInput struct {
+	SetHeaders      []HTTPHeaderInput `json:"setHeaders"`
+	RemoveHeaders   []string          `json:"removeHeaders"`
+	FollowRedirects *bool             `json:"followRedirects"`
+}
+
+type SavedSearch struct {
+	ID        int64                 `json:"id"`
+	Name      string                `json:"name"`
+	Filter    *HTTPRequestLogFilter `json:"filter"`
+	CreatedAt *time.Time            `json:"createdAt"`
+	UpdatedAt *time.Time            `json:"updatedAt"`
+}
+
 type ScopeHeader struct {
 	Key   *string `json:"key"`
 	Value *string `json:"value"`
@@ -72,68 +339,151 @@ type ScopeHeaderInput struct {
 }
 
 type ScopeRule struct {
-	URL    *string      `json:"url"`
-	Header *ScopeHeader `json:"header"`
-	Body   *string      `json:"body"`
+	URL     *string      `json:"url"`
+	Header  *ScopeHeader `json:"header"`
+	Body    *string      `json:"body"`
+	Methods []string     `json:"methods"`
 }
 
 type ScopeRuleInput struct {
-	URL    *string           `json:"url"`
-	Header *ScopeHeaderInput `json:"header"`
-	Body   *string           `json:"body"`
+	URL     *string           `json:"url"`
+	Header  *ScopeHeaderInput `json:"header"`
+	Body    *string           `json:"body"`
+	Methods []string          `json:"methods"`
+}
+
+type Tag struct {
+	Name  string  `json:"name"`
+	Color *string `json:"color"`
+}
+
+type CacheStatus string
+
+const (
+	CacheStatusHit     CacheStatus = "HIT"
+	CacheStatusMiss    CacheStatus = "MISS"
+	CacheStatusUnknown CacheStatus = "UNKNOWN"
+)
+
+var AllCacheStatus = []CacheStatus{
+	CacheStatusHit,
+	CacheStatusMiss,
+	CacheStatusUnknown,
+}
+
+func (e CacheStatus) IsValid() bool {
+	switch e {
+	case CacheStatusHit, CacheStatusMiss, CacheStatusUnknown:
+		return true
+	}
+	return false
+}
+
+func (e CacheStatus) String() string {
+	return string(e)
+}
+
+func (e *CacheStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CacheStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CacheStatus", str)
+	}
+	return nil
+}
+
+func (e CacheStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type DiffOp string
+
+const (
+	DiffOpInsert DiffOp = "INSERT"
+	DiffOpDelete DiffOp = "DELETE"
+)
+
+var AllDiffOp = []DiffOp{
+	DiffOpInsert,
+	DiffOpDelete,
+}
+
+func (e DiffOp) IsValid() bool {
+	switch e {
+	case DiffOpInsert, DiffOpDelete:
+		return true
+	}
+	return false
+}
+
+func (e DiffOp) String() string {
+	return string(e)
+}
+
+func (e *DiffOp) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DiffOp(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DiffOp", str)
+	}
+	return nil
+}
+
+func (e DiffOp) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-type HTTPMethod string
+type StatusClass string
 
 const (
-	HTTPMethodGet     HTTPMethod = "GET"
-	HTTPMethodHead    HTTPMethod = "HEAD"
-	HTTPMethodPost    HTTPMethod = "POST"
-	HTTPMethodPut     HTTPMethod = "PUT"
-	HTTPMethodDelete  HTTPMethod = "DELETE"
-	HTTPMethodConnect HTTPMethod = "CONNECT"
-	HTTPMethodOptions HTTPMethod = "OPTIONS"
-	HTTPMethodTrace   HTTPMethod = "TRACE"
-	HTTPMethodPatch   HTTPMethod = "PATCH"
+	StatusClassInformational StatusClass = "INFORMATIONAL"
+	StatusClassSuccess       StatusClass = "SUCCESS"
+	StatusClassRedirect      StatusClass = "REDIRECT"
+	StatusClassClientError   StatusClass = "CLIENT_ERROR"
+	StatusClassServerError   StatusClass = "SERVER_ERROR"
 )
 
-var AllHTTPMethod = []HTTPMethod{
-	HTTPMethodGet,
-	HTTPMethodHead,
-	HTTPMethodPost,
-	HTTPMethodPut,
-	HTTPMethodDelete,
-	HTTPMethodConnect,
-	HTTPMethodOptions,
-	HTTPMethodTrace,
-	HTTPMethodPatch,
+var AllStatusClass = []StatusClass{
+	StatusClassInformational,
+	StatusClassSuccess,
+	StatusClassRedirect,
+	StatusClassClientError,
+	StatusClassServerError,
 }
 
-func (e HTTPMethod) IsValid() bool {
+func (e StatusClass) IsValid() bool {
 	switch e {
-	case HTTPMethodGet, HTTPMethodHead, HTTPMethodPost, HTTPMethodPut, HTTPMethodDelete, HTTPMethodConnect, HTTPMethodOptions, HTTPMethodTrace, HTTPMethodPatch:
+	case StatusClassInformational, StatusClassSuccess, StatusClassRedirect, StatusClassClientError, StatusClassServerError:
 		return true
 	}
 	return false
 }
 
-func (e HTTPMethod) String() string {
+func (e StatusClass) String() string {
 	return string(e)
 }
 
-func (e *HTTPMethod) UnmarshalGQL(v interface{}) error {
+func (e *StatusClass) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = HTTPMethod(str)
+	*e = StatusClass(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid HttpMethod", str)
+		return fmt.Errorf("%s is not a valid StatusClass", str)
 	}
 	return nil
 }
 
-func (e HTTPMethod) MarshalGQL(w io.Writer) {
+func (e StatusClass) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
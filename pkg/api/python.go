@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// pythonSkippedHeaders are headers that are set by the `requests` library (or
+// the underlying connection), and are left out of Python snippets.
+var pythonSkippedHeaders = map[string]bool{
+	"Connection":        true,
+	"Content-Length":    true,
+	"Keep-Alive":        true,
+	"Proxy-Connection":  true,
+	"Transfer-Encoding": true,
+}
+
+func (r *httpRequestLogResolver) AsPython(ctx context.Context, obj *HTTPRequestLog) (*string, error) {
+	snippet := pythonSnippet(obj)
+	return &snippet, nil
+}
+
+// pythonSnippet returns a Python snippet that sends a request log with the
+// `requests` library. Values of repeated headers are joined, because headers
+// are passed as a dict.
+func pythonSnippet(reqLog *HTTPRequestLog) string {
+	headers := make(map[string][]string)
+
+	var keys []string
+
+	for _, header := range reqLog.Headers {
+		key := header.Key
+		if pythonSkippedHeaders[key] {
+			continue
+		}
+
+		// A decoded body is sent as is, so it must not be labeled as encoded.
+		if key == "Content-Encoding" && reqLog.BodyDecoded {
+			continue
+		}
+
+		if _, ok := headers[key]; !ok {
+			keys = append(keys, key)
+		}
+
+		headers[key] = append(headers[key], header.Value)
+	}
+
+	// The `Host` header is only needed when it differs from the URL host.
+	if reqLog.HostHeader != nil {
+		if u, err := url.Parse(reqLog.URL); err != nil || u.Host != *reqLog.HostHeader {
+			keys = append(keys, "Host")
+			headers["Host"] = []string{*reqLog.HostHeader}
+		}
+	}
+
+	sort.Strings(keys)
+
+	b := &strings.Builder{}
+
+	b.WriteString("import requests\n\nresponse = requests.request(\n")
+	fmt.Fprintf(b, "    %v,\n", pythonString(string(reqLog.Method)))
+	fmt.Fprintf(b, "    %v,\n", pythonString(reqLog.URL))
+
+	if len(keys) > 0 {
+		b.WriteString("    headers={\n")
+
+		for _, key := range keys {
+			sep := ", "
+			if key == "Cookie" {
+				sep = "; "
+			}
+
+			fmt.Fprintf(b, "        %v: %v,\n", pythonString(key), pythonString(strings.Join(headers[key], sep)))
+		}
+
+		b.WriteString("    },\n")
+	}
+
+	if reqLog.Body != nil && *reqLog.Body != "" {
+		fmt.Fprintf(b, "    data=%v,\n", pythonString(*reqLog.Body))
+	}
+
+	b.WriteString(")\n")
+
+	return b.String()
+}
+
+// pythonString returns s as a double quoted Python string literal. If s isn't
+// valid UTF-8, a bytes literal is returned.
+func pythonString(s string) string {
+	b := &strings.Builder{}
+
+	if !utf8.ValidString(s) {
+		b.WriteString(`b"`)
+
+		for i := 0; i < len(s); i++ {
+			writePythonEscaped(b, rune(s[i]), s[i] >= utf8.RuneSelf)
+		}
+
+		b.WriteString(`"`)
+
+		return b.String()
+	}
+
+	b.WriteString(`"`)
+
+	for _, r := range s {
+		writePythonEscaped(b, r, false)
+	}
+
+	b.WriteString(`"`)
+
+	return b.String()
+}
+
+// writePythonEscaped writes r to b, escaped for use in a double quoted Python
+// string literal. If raw is true, r is a byte that's written as a hex escape.
+func writePythonEscaped(b *strings.Builder, r rune, raw bool) {
+	switch {
+	case raw:
+		fmt.Fprintf(b, `\x%02x`, r)
+	case r == '"':
+		b.WriteString(`\"`)
+	case r == '\\':
+		b.WriteString(`\\`)
+	case r == '\n':
+		b.WriteString(`\n`)
+	case r == '\r':
+		b.WriteString(`\r`)
+	case r == '\t':
+		b.WriteString(`\t`)
+	case r < utf8.RuneSelf && !unicode.IsPrint(r):
+		fmt.Fprintf(b, `\x%02x`, r)
+	case !unicode.IsPrint(r) && r <= 0xffff:
+		fmt.Fprintf(b, `\u%04x`, r)
+	case !unicode.IsPrint(r):
+		fmt.Fprintf(b, `\U%08x`, r)
+	default:
+		b.WriteRune(r)
+	}
+}
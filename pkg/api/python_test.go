@@ -0,0 +1,72 @@
+package api
+
+import "testing"
+
+func TestPythonSnippet(t *testing.T) {
+	t.Parallel()
+
+	body := "{\"foo\": \"bar\"}\nline \\ two"
+	hostHeader := "example.com"
+
+	reqLog := &HTTPRequestLog{
+		Method:     HTTPMethod("POST"),
+		URL:        "https://example.com/foo?bar=baz",
+		HostHeader: &hostHeader,
+		Headers: []HTTPHeader{
+			{Key: "X-Quote", Value: `say "hi"`},
+			{Key: "X-Newline", Value: "foo\r\nbar"},
+			{Key: "Content-Length", Value: "27"},
+			{Key: "Connection", Value: "keep-alive"},
+			{Key: "Accept", Value: "text/html"},
+			{Key: "Accept", Value: "application/json"},
+		},
+		Body: &body,
+	}
+
+	exp := `import requests
+
+response = requests.request(
+    "POST",
+    "https://example.com/foo?bar=baz",
+    headers={
+        "Accept": "text/html, application/json",
+        "X-Newline": "foo\r\nbar",
+        "X-Quote": "say \"hi\"",
+    },
+    data="{\"foo\": \"bar\"}\nline \\ two",
+)
+`
+
+	if got := pythonSnippet(reqLog); got != exp {
+		t.Errorf("expected snippet:\n%v\ngot:\n%v", exp, got)
+	}
+}
+
+func TestPythonString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		s    string
+		exp  string
+	}{
+		{name: "plain", s: "foo bar", exp: `"foo bar"`},
+		{name: "quotes and backslashes", s: `"foo" \ 'bar'`, exp: `"\"foo\" \\ 'bar'"`},
+		{name: "newlines and tabs", s: "foo\r\n\tbar", exp: `"foo\r\n\tbar"`},
+		{name: "control characters", s: "foo\x00\x1b", exp: `"foo\x00\x1b"`},
+		{name: "unicode", s: "héllo", exp: `"héllo"`},
+		{name: "non-printable unicode", s: "foo\u200b", exp: `"foo\u200b"`},
+		{name: "invalid UTF-8", s: "foo\xff\"", exp: `b"foo\xff\""`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := pythonString(tt.s); got != tt.exp {
+				t.Errorf("expected %v, got: %v", tt.exp, got)
+			}
+		})
+	}
+}
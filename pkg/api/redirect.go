@@ -0,0 +1,26 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+)
+
+func (r *httpRequestLogResolver) RedirectChain(ctx context.Context, obj *HTTPRequestLog) ([]HTTPRequestLog, error) {
+	reqs, err := r.RequestLogService.FindRedirectChain(ctx, obj.ID)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get redirect chain: %w", err)
+	}
+
+	logs := make([]HTTPRequestLog, len(reqs))
+
+	for i, req := range reqs {
+		logs[i] = parseRequestLog(req)
+	}
+
+	return logs, nil
+}
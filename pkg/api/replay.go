@@ -0,0 +1,50 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func (r *mutationResolver) ReplayHTTPRequestLog(
+	ctx context.Context,
+	id int64,
+	count int,
+	concurrency *int,
+) (*ReplaySummary, error) {
+	n := 1
+	if concurrency != nil {
+		n = *concurrency
+	}
+
+	summary, err := r.RequestLogService.ReplayRequest(ctx, id, count, n)
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, reqlog.ErrRequestNotFound):
+		return nil, notFoundErr(ctx, "Request log not found.")
+	case errors.Is(err, reqlog.ErrInvalidReplay):
+		return nil, newError(ctx, ErrCodeBadInput, fmt.Sprintf("Invalid replay: %v", err))
+	case err != nil:
+		return nil, fmt.Errorf("could not replay request: %w", err)
+	}
+
+	result := &ReplaySummary{
+		Count:        summary.Count,
+		SuccessCount: summary.Succeeded,
+		ErrorCount:   summary.Failed,
+	}
+
+	if summary.Succeeded > 0 {
+		minLatency := durationToMilliseconds(summary.MinLatency)
+		maxLatency := durationToMilliseconds(summary.MaxLatency)
+		avgLatency := durationToMilliseconds(summary.AvgLatency)
+
+		result.MinLatency, result.MaxLatency, result.AvgLatency = &minLatency, &maxLatency, &avgLatency
+	}
+
+	return result, nil
+}
@@ -4,13 +4,15 @@ package api
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/dstotijn/hetty/pkg/proj"
 	"github.com/dstotijn/hetty/pkg/reqlog"
@@ -22,33 +24,116 @@ type Resolver struct {
 	RequestLogService *reqlog.Service
 	ProjectService    *proj.Service
 	ScopeService      *scope.Scope
+	// SniffRules are consulted to detect the content type of bodies, before
+	// falling back to `http.DetectContentType`.
+	SniffRules []SniffRule
+	// MaxListBodyRows is the maximum number of request logs the list query
+	// returns when response bodies are selected. Above it, the query fails, and
+	// bodies should be fetched per request log instead. Zero means unlimited.
+	MaxListBodyRows int
 }
 
 type (
-	queryResolver    struct{ *Resolver }
-	mutationResolver struct{ *Resolver }
+	queryResolver               struct{ *Resolver }
+	mutationResolver            struct{ *Resolver }
+	httpRequestLogResolver      struct{ *Resolver }
+	httpRequestLogStatsResolver struct{ *Resolver }
+	httpResponseLogResolver     struct{ *Resolver }
 )
 
-func (r *Resolver) Query() QueryResolver       { return &queryResolver{r} }
-func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+func (r *Resolver) Query() QueryResolver                   { return &queryResolver{r} }
+func (r *Resolver) Mutation() MutationResolver             { return &mutationResolver{r} }
+func (r *Resolver) HttpRequestLog() HttpRequestLogResolver { return &httpRequestLogResolver{r} }
+func (r *Resolver) HttpRequestLogStats() HttpRequestLogStatsResolver {
+	return &httpRequestLogStatsResolver{r}
+}
+func (r *Resolver) HttpResponseLog() HttpResponseLogResolver { return &httpResponseLogResolver{r} }
 
-func (r *queryResolver) HTTPRequestLogs(ctx context.Context) ([]HTTPRequestLog, error) {
-	reqs, err := r.RequestLogService.FindRequests(ctx)
-	if errors.Is(err, proj.ErrNoProject) {
+func (r *queryResolver) HTTPRequestLogs(
+	ctx context.Context,
+	savedSearchID *int64,
+	filterQuery *string,
+) ([]HTTPRequestLog, error) {
+	var err error
+
+	if savedSearchID != nil && filterQuery != nil {
+		return nil, newError(ctx, ErrCodeBadInput, "Only one of `savedSearchId` and `filter` can be set.")
+	}
+
+	// The filter is needed for highlighting search matches.
+	filter := r.RequestLogService.FindReqsFilter
+
+	if filterQuery != nil {
+		var queryErr *filterQueryError
+
+		filter, err = parseFilterQuery(*filterQuery)
+		if errors.As(err, &queryErr) {
+			return nil, invalidFilterErr(ctx, queryErr)
+		} else if err != nil {
+			return nil, fmt.Errorf("could not parse filter: %w", err)
+		}
+	} else if savedSearchID != nil {
+		var savedSearch reqlog.SavedSearch
+
+		savedSearch, err = r.RequestLogService.FindSavedSearchByID(ctx, *savedSearchID)
+		filter = savedSearch.Filter
+	}
+
+	// Response bodies are only loaded for a bounded number of request logs.
+	if err == nil && r.MaxListBodyRows > 0 && responseBodySelected(ctx) {
+		var count int64
+
+		count, err = r.RequestLogService.CountRequests(ctx, filter)
+		if err == nil && count > int64(r.MaxListBodyRows) {
+			return nil, tooManyBodiesErr(ctx, r.MaxListBodyRows)
+		}
+	}
+
+	var reqs []reqlog.Request
+
+	if err == nil {
+		reqs, err = r.RequestLogService.FindRequestsByFilter(ctx, filter)
+	}
+
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
 		return nil, noActiveProjectErr(ctx)
-	} else if err != nil {
+	case errors.Is(err, reqlog.ErrSavedSearchNotFound):
+		return nil, notFoundErr(ctx, "Saved search not found.")
+	case err != nil:
 		return nil, fmt.Errorf("could not query repository for requests: %w", err)
 	}
 
 	logs := make([]HTTPRequestLog, len(reqs))
 
 	for i, req := range reqs {
-		req, err := parseRequestLog(req)
-		if err != nil {
-			return nil, err
+		logs[i] = parseRequestLog(req)
+
+		if filter.SearchExpr != nil {
+			setBodyMatches(&logs[i], filter)
 		}
+	}
+
+	return logs, nil
+}
+
+func (r *queryResolver) RecentHTTPRequestLogs(ctx context.Context, limit *int) ([]HTTPRequestLog, error) {
+	var n int
+	if limit != nil {
+		n = *limit
+	}
+
+	reqs, err := r.RequestLogService.RecentRequests(ctx, n)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not query repository for recent requests: %w", err)
+	}
 
-		logs[i] = req
+	logs := make([]HTTPRequestLog, len(reqs))
+
+	for i, req := range reqs {
+		logs[i] = parseRequestLog(req)
 	}
 
 	return logs, nil
@@ -57,30 +142,107 @@ func (r *queryResolver) HTTPRequestLogs(ctx context.Context) ([]HTTPRequestLog,
 func (r *queryResolver) HTTPRequestLog(ctx context.Context, id int64) (*HTTPRequestLog, error) {
 	log, err := r.RequestLogService.FindRequestLogByID(ctx, id)
 	if errors.Is(err, reqlog.ErrRequestNotFound) {
-		return nil, nil
+		return nil, notFoundErr(ctx, "Request log not found.")
 	} else if err != nil {
 		return nil, fmt.Errorf("could not get request by ID: %w", err)
 	}
 
-	req, err := parseRequestLog(log)
-	if err != nil {
-		return nil, err
-	}
+	req := parseRequestLog(log)
 
 	return &req, nil
 }
 
-func parseRequestLog(req reqlog.Request) (HTTPRequestLog, error) {
-	method := HTTPMethod(req.Request.Method)
-	if method != "" && !method.IsValid() {
-		return HTTPRequestLog{}, fmt.Errorf("request has invalid method: %v", method)
+func (r *queryResolver) HTTPRequestLogsByIDs(ctx context.Context, ids []int64) ([]HTTPRequestLog, error) {
+	reqs, err := r.RequestLogService.FindRequestLogsByIDs(ctx, ids)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get requests by IDs: %w", err)
+	}
+
+	logs := make([]HTTPRequestLog, len(reqs))
+
+	for i, req := range reqs {
+		logs[i] = parseRequestLog(req)
+	}
+
+	return logs, nil
+}
+
+func (r *queryResolver) HTTPRequestLogRaw(ctx context.Context, id int64) (*HTTPRequestLogRaw, error) {
+	// All fields are needed for reconstruction, regardless of the selection.
+	log, err := r.RequestLogService.FindRequestLogByID(reqlog.WithAllFields(ctx), id)
+	if errors.Is(err, reqlog.ErrRequestNotFound) {
+		return nil, notFoundErr(ctx, "Request log not found.")
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get request by ID: %w", err)
+	}
+
+	raw := &HTTPRequestLogRaw{
+		RequestRaw: string(reqlog.RawRequest(log)),
 	}
 
+	if log.Response != nil {
+		responseRaw := string(reqlog.RawResponse(*log.Response))
+		raw.ResponseRaw = &responseRaw
+	}
+
+	return raw, nil
+}
+
+func (r *queryResolver) HTTPRequestLogParents(ctx context.Context, id int64) ([]HTTPRequestLog, error) {
+	reqs, err := r.RequestLogService.FindParentRequests(ctx, id)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get parent requests: %w", err)
+	}
+
+	logs := make([]HTTPRequestLog, len(reqs))
+
+	for i, req := range reqs {
+		logs[i] = parseRequestLog(req)
+	}
+
+	return logs, nil
+}
+
+func (r *queryResolver) RequestsBySession(
+	ctx context.Context,
+	cookieName string,
+	value string,
+) ([]HTTPRequestLog, error) {
+	reqs, err := r.RequestLogService.FindRequestsBySession(ctx, cookieName, value)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get requests by session: %w", err)
+	}
+
+	logs := make([]HTTPRequestLog, len(reqs))
+
+	for i, req := range reqs {
+		logs[i] = parseRequestLog(req)
+	}
+
+	return logs, nil
+}
+
+func parseRequestLog(req reqlog.Request) HTTPRequestLog {
 	log := HTTPRequestLog{
-		ID:        req.ID,
-		Proto:     req.Request.Proto,
-		Method:    method,
-		Timestamp: req.Timestamp,
+		ID:               req.ID,
+		Proto:            req.Request.Proto,
+		Method:           HTTPMethod(req.Request.Method),
+		Timestamp:        req.Timestamp,
+		RelativeTime:     relativeTime(req.Timestamp, time.Now().UTC()),
+		HeadersTruncated: req.HeadersTruncated,
+		BodyTruncated:    req.BodyTruncated,
+		RequestBodySize:  int(req.BodySize),
+		TransferSize:     int(req.BodySize),
+		IsBaseline:       req.IsBaseline,
+		Starred:          req.Starred,
+		Unread:           req.Unread,
+		ClientCert:       req.ClientCert,
 	}
 
 	if req.Request.URL != nil {
@@ -88,8 +250,83 @@ func parseRequestLog(req reqlog.Request) (HTTPRequestLog, error) {
 	}
 
 	if len(req.Body) > 0 {
-		reqBody := string(req.Body)
+		body, decoded := decodeBody(req.Request.Header, req.Body)
+		reqBody := string(body)
 		log.Body = &reqBody
+		log.BodyDecoded = decoded
+	}
+
+	if req.Request.Host != "" {
+		hostHeader := req.Request.Host
+		log.HostHeader = &hostHeader
+	}
+
+	if req.Fingerprint != "" {
+		fingerprint := req.Fingerprint
+		log.Fingerprint = &fingerprint
+	}
+
+	if req.CanonicalURL != "" {
+		canonicalURL := req.CanonicalURL
+		log.CanonicalURL = &canonicalURL
+	}
+
+	if req.Referer != "" {
+		referer := req.Referer
+		log.Referer = &referer
+	}
+
+	if req.ServerAddr != "" {
+		serverAddr := req.ServerAddr
+		log.ServerAddr = &serverAddr
+	}
+
+	if req.ConnID != 0 {
+		connID := req.ConnID
+		log.ConnectionID = &connID
+	}
+
+	if req.GRPCMethod != "" {
+		grpcMethod := req.GRPCMethod
+		log.GrpcMethod = &grpcMethod
+		log.BodyProtobuf = true
+	}
+
+	if req.SNI != "" {
+		sni := req.SNI
+		log.Sni = &sni
+	}
+
+	if req.RedirectedFrom != 0 {
+		redirectedFrom := req.RedirectedFrom
+		log.RedirectedFromID = &redirectedFrom
+	}
+
+	if req.Listener != "" {
+		listener := req.Listener
+		log.Listener = &listener
+	}
+
+	if req.BodyHash != "" {
+		bodyHash := req.BodyHash
+		log.BodyHash = &bodyHash
+	}
+
+	if req.HeadersErr != nil {
+		headersErr := req.HeadersErr.Error()
+		log.HeadersError = &headersErr
+	}
+
+	if req.BodyLineCount != nil {
+		n := int(*req.BodyLineCount)
+		log.BodyLineCount = &n
+	}
+
+	if req.Request.TLS != nil {
+		tlsVersion := tlsVersionName(req.Request.TLS.Version)
+		tlsCipher := tls.CipherSuiteName(req.Request.TLS.CipherSuite)
+		log.TLSVersion = &tlsVersion
+		log.TLSCipher = &tlsCipher
 	}
 
 	if req.Request.Header != nil {
@@ -106,11 +343,46 @@ func parseRequestLog(req reqlog.Request) (HTTPRequestLog, error) {
 	}
 
 	if req.Response != nil {
+		resBodySize := int(req.Response.BodySize)
+		log.ResponseBodySize = &resBodySize
+		log.TransferSize += resBodySize
+
 		log.Response = &HTTPResponseLog{
-			RequestID:  req.Response.RequestID,
-			Proto:      req.Response.Response.Proto,
-			StatusCode: req.Response.Response.StatusCode,
+			RequestID:             req.Response.RequestID,
+			Proto:                 req.Response.Response.Proto,
+			StatusCode:            req.Response.Response.StatusCode,
+			ContentLengthMismatch: req.Response.ContentLengthMismatch,
+			BodySkipped:           req.Response.BodySkipped,
+			HeadersTruncated:      req.Response.HeadersTruncated,
+			BodyTruncated:         req.Response.BodyTruncated,
+			BodyProtobuf:          req.GRPCMethod != "",
+			CacheStatus:           CacheStatus(req.Response.CacheStatus),
+		}
+
+		if req.Response.BodyLineCount != nil {
+			n := int(*req.Response.BodyLineCount)
+			log.Response.BodyLineCount = &n
+		}
+
+		if req.Response.BodyHash != "" {
+			bodyHash := req.Response.BodyHash
+			log.Response.BodyHash = &bodyHash
+		}
+
+		if req.Response.HeadersErr != nil {
+			headersErr := req.Response.HeadersErr.Error()
+			log.Response.HeadersError = &headersErr
 		}
+
+		if timings := req.Response.Timings; !timings.IsZero() {
+			log.Response.Timings = &HTTPTimings{
+				DNSMs:     durationPtrToMilliseconds(timings.DNS),
+				ConnectMs: durationPtrToMilliseconds(timings.Connect),
+				TLSMs:     durationPtrToMilliseconds(timings.TLS),
+				TtfbMs:    durationPtrToMilliseconds(timings.TTFB),
+			}
+		}
+
 		statusReasonSubs := strings.SplitN(req.Response.Response.Status, " ", 2)
 
 		if len(statusReasonSubs) == 2 {
@@ -118,8 +390,17 @@ func parseRequestLog(req reqlog.Request) (HTTPRequestLog, error) {
 		}
 
 		if len(req.Response.Body) > 0 {
-			resBody := string(req.Response.Body)
+			// Gzip encoded response bodies are decoded before they're stored.
+			body := req.Response.Body
+			decoded := req.Response.Response.Header.Get("Content-Encoding") == "gzip"
+
+			if !decoded {
+				body, decoded = decodeBody(req.Response.Response.Header, req.Response.Body)
+			}
+
+			resBody := string(body)
 			log.Response.Body = &resBody
+			log.Response.BodyDecoded = decoded
 		}
 
 		if req.Response.Response.Header != nil {
@@ -136,13 +417,28 @@ func parseRequestLog(req reqlog.Request) (HTTPRequestLog, error) {
 		}
 	}
 
-	return log, nil
+	return log
+}
+
+func tlsVersionName(version uint16) string {
+	switch version {
+	case tls.VersionTLS10:
+		return "TLS 1.0"
+	case tls.VersionTLS11:
+		return "TLS 1.1"
+	case tls.VersionTLS12:
+		return "TLS 1.2"
+	case tls.VersionTLS13:
+		return "TLS 1.3"
+	default:
+		return fmt.Sprintf("0x%04X", version)
+	}
 }
 
 func (r *mutationResolver) OpenProject(ctx context.Context, name string) (*Project, error) {
 	p, err := r.ProjectService.Open(ctx, name)
 	if errors.Is(err, proj.ErrInvalidName) {
-		return nil, gqlerror.Errorf("Project name must only contain alphanumeric or space chars.")
+		return nil, newError(ctx, ErrCodeBadInput, "Project name must only contain alphanumeric or space chars.")
 	} else if err != nil {
 		return nil, fmt.Errorf("could not open project: %w", err)
 	}
@@ -189,6 +485,32 @@ func (r *queryResolver) Scope(ctx context.Context) ([]ScopeRule, error) {
 	return scopeToScopeRules(rules), nil
 }
 
+func (r *queryResolver) ScopeTimestamps(ctx context.Context) (*AuditTimestamps, error) {
+	createdAt, updatedAt, err := r.ScopeService.Timestamps(ctx)
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, proj.ErrNoSettings):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("could not get scope timestamps: %w", err)
+	}
+
+	return &AuditTimestamps{
+		CreatedAt: timeToPtr(createdAt),
+		UpdatedAt: timeToPtr(updatedAt),
+	}, nil
+}
+
+// timeToPtr returns nil for a zero time.
+func timeToPtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
 func regexpToStringPtr(r *regexp.Regexp) *string {
 	if r == nil {
 		return nil
@@ -253,13 +575,24 @@ func (r *mutationResolver) SetScope(ctx context.Context, input []ScopeRuleInput)
 			return nil, fmt.Errorf("invalid body in scope rule: %w", err)
 		}
 
+		var methods []string
+
+		for _, method := range rule.Methods {
+			if method == "" {
+				return nil, errors.New("invalid method in scope rule: method cannot be empty")
+			}
+
+			methods = append(methods, strings.ToUpper(method))
+		}
+
 		rules[i] = scope.Rule{
 			URL: u,
 			Header: scope.Header{
 				Key:   headerKey,
 				Value: headerValue,
 			},
-			Body: body,
+			Body:    body,
+			Methods: methods,
 		}
 	}
 
@@ -293,6 +626,139 @@ func (r *mutationResolver) SetHTTPRequestLogFilter(
 	return findReqFilterToHTTPReqLogFilter(filter), nil
 }
 
+func (r *queryResolver) SavedSearches(ctx context.Context) ([]SavedSearch, error) {
+	savedSearches, err := r.RequestLogService.SavedSearches(ctx)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get saved searches: %w", err)
+	}
+
+	result := make([]SavedSearch, len(savedSearches))
+	for i, savedSearch := range savedSearches {
+		result[i] = parseSavedSearch(savedSearch)
+	}
+
+	return result, nil
+}
+
+func (r *mutationResolver) SaveSearch(
+	ctx context.Context,
+	name string,
+	input HTTPRequestLogFilterInput,
+) (*SavedSearch, error) {
+	filter, err := findRequestsFilterFromInput(&input)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse request log filter: %w", err)
+	}
+
+	savedSearch, err := r.RequestLogService.SaveSearch(ctx, name, filter)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not save search: %w", err)
+	}
+
+	result := parseSavedSearch(savedSearch)
+
+	return &result, nil
+}
+
+func (r *mutationResolver) DeleteSearch(ctx context.Context, id int64) (*DeleteSavedSearchResult, error) {
+	err := r.RequestLogService.DeleteSavedSearch(ctx, id)
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, reqlog.ErrSavedSearchNotFound):
+		return nil, notFoundErr(ctx, "Saved search not found.")
+	case err != nil:
+		return nil, fmt.Errorf("could not delete saved search: %w", err)
+	}
+
+	return &DeleteSavedSearchResult{
+		Success: true,
+	}, nil
+}
+
+func (r *queryResolver) HTTPRequestLogDuplicates(ctx context.Context) ([]HTTPRequestLogDuplicates, error) {
+	clusters, err := r.RequestLogService.FindDuplicates(ctx)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not find duplicate requests: %w", err)
+	}
+
+	result := make([]HTTPRequestLogDuplicates, len(clusters))
+	for i, cluster := range clusters {
+		result[i] = HTTPRequestLogDuplicates{
+			Fingerprint: cluster.Fingerprint,
+			RequestIds:  cluster.RequestIDs,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *queryResolver) HTTPRequestLogStats(ctx context.Context) (*HTTPRequestLogStats, error) {
+	duplicateCount, err := r.RequestLogService.DuplicateCount(ctx)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not count duplicate requests: %w", err)
+	}
+
+	unreadCount, err := r.RequestLogService.UnreadCount(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not count unread requests: %w", err)
+	}
+
+	return &HTTPRequestLogStats{
+		DuplicateCount: int(duplicateCount),
+		UnreadCount:    int(unreadCount),
+	}, nil
+}
+
+func (r *mutationResolver) DeleteDuplicateHTTPRequestLogs(
+	ctx context.Context,
+) (*DeleteDuplicateHTTPRequestLogsResult, error) {
+	n, err := r.RequestLogService.DeleteDuplicates(ctx)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not delete duplicate requests: %w", err)
+	}
+
+	return &DeleteDuplicateHTTPRequestLogsResult{
+		DeletedCount: int(n),
+	}, nil
+}
+
+func (r *mutationResolver) MarkAllRead(ctx context.Context) (int, error) {
+	n, err := r.RequestLogService.MarkAllRead(ctx)
+	if errors.Is(err, proj.ErrNoProject) {
+		return 0, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return 0, fmt.Errorf("could not mark requests as read: %w", err)
+	}
+
+	return int(n), nil
+}
+
+func parseSavedSearch(savedSearch reqlog.SavedSearch) SavedSearch {
+	filter := findReqFilterToHTTPReqLogFilter(savedSearch.Filter)
+	if filter == nil {
+		filter = &HTTPRequestLogFilter{}
+	}
+
+	return SavedSearch{
+		ID:        savedSearch.ID,
+		Name:      savedSearch.Name,
+		Filter:    filter,
+		CreatedAt: timeToPtr(savedSearch.CreatedAt),
+		UpdatedAt: timeToPtr(savedSearch.UpdatedAt),
+	}
+}
+
 func stringPtrToRegexp(s *string) (*regexp.Regexp, error) {
 	if s == nil {
 		return nil, nil
@@ -313,6 +779,7 @@ func scopeToScopeRules(rules []scope.Rule) []ScopeRule {
 		}
 
 		scopeRules[i].Body = regexpToStringPtr(rule.Body)
+		scopeRules[i].Methods = rule.Methods
 	}
 
 	return scopeRules
@@ -337,32 +804,250 @@ func findRequestsFilterFromInput(input *HTTPRequestLogFilterInput) (filter reqlo
 		filter.SearchExpr = expr
 	}
 
+	if input.PathPrefix != nil {
+		filter.PathPrefix = *input.PathPrefix
+	}
+
+	if input.QueryContains != nil {
+		filter.QueryContains = *input.QueryContains
+	}
+
+	if input.CaseInsensitive != nil {
+		filter.CaseInsensitive = *input.CaseInsensitive
+	}
+
+	if input.RequestContentType != nil {
+		filter.RequestContentType = *input.RequestContentType
+	}
+
+	if input.ResponseContentType != nil {
+		filter.ResponseContentType = *input.ResponseContentType
+	}
+
+	if len(input.Protos) > 0 {
+		filter.Protos = input.Protos
+	}
+
+	if input.ResponseSize != nil {
+		filter.ResponseSizeMin = intToInt64Ptr(input.ResponseSize.Min)
+		filter.ResponseSizeMax = intToInt64Ptr(input.ResponseSize.Max)
+	}
+
+	if input.InScope != nil {
+		inScope := *input.InScope
+		filter.InScope = &inScope
+	}
+
+	if input.JSONPathMatch != nil {
+		filter.JSONPathMatch = &reqlog.JSONPathMatch{
+			Path:  input.JSONPathMatch.Path,
+			Value: input.JSONPathMatch.Value,
+		}
+	}
+
+	filter.RequestHeaders = headerMatchesFromInput(input.RequestHeaders)
+	filter.ResponseHeaders = headerMatchesFromInput(input.ResponseHeaders)
+
+	if input.ConnectionID != nil {
+		filter.ConnID = *input.ConnectionID
+	}
+
+	if input.Unread != nil {
+		unread := *input.Unread
+		filter.Unread = &unread
+	}
+
+	if input.CacheStatus != nil {
+		filter.CacheStatus = reqlog.CacheStatus(*input.CacheStatus)
+	}
+
+	for _, class := range input.StatusClass {
+		filter.StatusClasses = append(filter.StatusClasses, reqlog.StatusClass(class))
+	}
+
+	if input.Starred != nil {
+		starred := *input.Starred
+		filter.Starred = &starred
+	}
+
+	if input.Sni != nil {
+		filter.SNI = *input.Sni
+	}
+
+	if input.Listener != nil {
+		filter.Listener = *input.Listener
+	}
+
 	return
 }
 
+func headerMatchesFromInput(input []HeaderMatchInput) []reqlog.HeaderMatch {
+	if len(input) == 0 {
+		return nil
+	}
+
+	matches := make([]reqlog.HeaderMatch, len(input))
+	for i, match := range input {
+		matches[i] = reqlog.HeaderMatch{Key: match.Key}
+		if match.Value != nil {
+			matches[i].Value = *match.Value
+		}
+	}
+
+	return matches
+}
+
+func headerMatchesToHTTPHeaderMatches(matches []reqlog.HeaderMatch) []HeaderMatch {
+	if len(matches) == 0 {
+		return nil
+	}
+
+	result := make([]HeaderMatch, len(matches))
+	for i, match := range matches {
+		result[i] = HeaderMatch{Key: match.Key}
+		if match.Value != "" {
+			value := match.Value
+			result[i].Value = &value
+		}
+	}
+
+	return result
+}
+
 func findReqFilterToHTTPReqLogFilter(findReqFilter reqlog.FindRequestsFilter) *HTTPRequestLogFilter {
-	empty := reqlog.FindRequestsFilter{}
-	if findReqFilter == empty {
+	if reflect.DeepEqual(findReqFilter, reqlog.FindRequestsFilter{}) {
 		return nil
 	}
 
 	httpReqLogFilter := &HTTPRequestLogFilter{
-		OnlyInScope: findReqFilter.OnlyInScope,
+		OnlyInScope:     findReqFilter.OnlyInScope,
+		CaseInsensitive: findReqFilter.CaseInsensitive,
 	}
 
 	if findReqFilter.RawSearchExpr != "" {
 		httpReqLogFilter.SearchExpression = &findReqFilter.RawSearchExpr
 	}
 
+	if findReqFilter.PathPrefix != "" {
+		httpReqLogFilter.PathPrefix = &findReqFilter.PathPrefix
+	}
+
+	if findReqFilter.QueryContains != "" {
+		httpReqLogFilter.QueryContains = &findReqFilter.QueryContains
+	}
+
+	if findReqFilter.RequestContentType != "" {
+		httpReqLogFilter.RequestContentType = &findReqFilter.RequestContentType
+	}
+
+	if findReqFilter.ResponseContentType != "" {
+		httpReqLogFilter.ResponseContentType = &findReqFilter.ResponseContentType
+	}
+
+	if len(findReqFilter.Protos) > 0 {
+		httpReqLogFilter.Protos = findReqFilter.Protos
+	}
+
+	if findReqFilter.ResponseSizeMin != nil || findReqFilter.ResponseSizeMax != nil {
+		httpReqLogFilter.ResponseSize = &IntRange{
+			Min: int64ToIntPtr(findReqFilter.ResponseSizeMin),
+			Max: int64ToIntPtr(findReqFilter.ResponseSizeMax),
+		}
+	}
+
+	if findReqFilter.InScope != nil {
+		inScope := *findReqFilter.InScope
+		httpReqLogFilter.InScope = &inScope
+	}
+
+	if findReqFilter.JSONPathMatch != nil {
+		httpReqLogFilter.JSONPathMatch = &JSONPathMatch{
+			Path:  findReqFilter.JSONPathMatch.Path,
+			Value: findReqFilter.JSONPathMatch.Value,
+		}
+	}
+
+	httpReqLogFilter.RequestHeaders = headerMatchesToHTTPHeaderMatches(findReqFilter.RequestHeaders)
+	httpReqLogFilter.ResponseHeaders = headerMatchesToHTTPHeaderMatches(findReqFilter.ResponseHeaders)
+
+	if findReqFilter.ConnID != 0 {
+		connID := findReqFilter.ConnID
+		httpReqLogFilter.ConnectionID = &connID
+	}
+
+	if findReqFilter.Unread != nil {
+		unread := *findReqFilter.Unread
+		httpReqLogFilter.Unread = &unread
+	}
+
+	if findReqFilter.CacheStatus != "" {
+		cacheStatus := CacheStatus(findReqFilter.CacheStatus)
+		httpReqLogFilter.CacheStatus = &cacheStatus
+	}
+
+	for _, class := range findReqFilter.StatusClasses {
+		httpReqLogFilter.StatusClass = append(httpReqLogFilter.StatusClass, StatusClass(class))
+	}
+
+	if findReqFilter.Starred != nil {
+		starred := *findReqFilter.Starred
+		httpReqLogFilter.Starred = &starred
+	}
+
+	if findReqFilter.SNI != "" {
+		sni := findReqFilter.SNI
+		httpReqLogFilter.Sni = &sni
+	}
+
+	if findReqFilter.Listener != "" {
+		listener := findReqFilter.Listener
+		httpReqLogFilter.Listener = &listener
+	}
+
 	return httpReqLogFilter
 }
 
-func noActiveProjectErr(ctx context.Context) error {
-	return &gqlerror.Error{
-		Path:    graphql.GetPath(ctx),
-		Message: "No active project.",
-		Extensions: map[string]interface{}{
-			"code": "no_active_project",
-		},
+func intToInt64Ptr(i *int) *int64 {
+	if i == nil {
+		return nil
 	}
+
+	v := int64(*i)
+
+	return &v
+}
+
+func int64ToIntPtr(i *int64) *int {
+	if i == nil {
+		return nil
+	}
+
+	v := int(*i)
+
+	return &v
+}
+
+// responseBodySelected reports whether a field of the response log is selected
+// that the stored response body is loaded for.
+func responseBodySelected(ctx context.Context) bool {
+	opCtx := graphql.GetOperationContext(ctx)
+
+	for _, reqField := range graphql.CollectFieldsCtx(ctx, nil) {
+		if reqField.Name != "response" {
+			continue
+		}
+
+		for _, resField := range graphql.CollectFields(opCtx, reqField.Selections, nil) {
+			switch resField.Name {
+			case "body", "bodyHex", "bodyType", "matches":
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func noActiveProjectErr(ctx context.Context) error {
+	return newError(ctx, ErrCodeNoActiveProject, "No active project.")
 }
@@ -0,0 +1,1076 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+
+	"github.com/dstotijn/hetty/pkg/db/sqlite"
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+func newTestResolver(t *testing.T) (*Resolver, *sqlite.Client) {
+	t.Helper()
+
+	db, err := sqlite.New(sqlite.Config{ProjectsPath: t.TempDir()})
+	if err != nil {
+		t.Fatalf("could not create database client: %v", err)
+	}
+
+	projService, err := proj.NewService(db)
+	if err != nil {
+		t.Fatalf("could not create project service: %v", err)
+	}
+
+	scope := scope.New(db, projService)
+	reqLogService := reqlog.NewService(reqlog.Config{
+		Scope:          scope,
+		ProjectService: projService,
+		Repository:     db,
+	})
+
+	if _, err := projService.Open(context.Background(), "test"); err != nil {
+		t.Fatalf("could not open project: %v", err)
+	}
+
+	t.Cleanup(func() { projService.Close() })
+
+	return &Resolver{
+		RequestLogService: reqLogService,
+		ProjectService:    projService,
+		ScopeService:      scope,
+	}, db
+}
+
+// addTestRequestLog stores reqLog, and reqLog.Response as its response log if
+// it's set, and returns the stored request log. Zero timestamps are set to the
+// current time.
+func addTestRequestLog(t *testing.T, db *sqlite.Client, reqLog reqlog.Request) *reqlog.Request {
+	t.Helper()
+
+	ctx := context.Background()
+
+	resLog := reqLog.Response
+	reqLog.Response = nil
+
+	if reqLog.Timestamp.IsZero() {
+		reqLog.Timestamp = time.Now()
+	}
+
+	stored, err := db.AddRequestLog(ctx, reqLog)
+	if err != nil {
+		t.Fatalf("could not add request log: %v", err)
+	}
+
+	if resLog == nil {
+		return stored
+	}
+
+	if resLog.Timestamp.IsZero() {
+		resLog.Timestamp = time.Now()
+	}
+
+	stored.Response = resLog
+
+	stored.Response, err = db.AddResponseLog(ctx, *stored)
+	if err != nil {
+		t.Fatalf("could not add response log: %v", err)
+	}
+
+	return stored
+}
+
+func newTestServer(t *testing.T) *handler.Server {
+	t.Helper()
+
+	resolver, _ := newTestResolver(t)
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver}))
+	srv.SetErrorPresenter(ErrorPresenter)
+
+	return srv
+}
+
+func TestHTTPRequestLogsWithoutResponseFields(t *testing.T) {
+	t.Parallel()
+
+	resolver, db := newTestResolver(t)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+	reqLog, err := db.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Search expressions can match on response fields, which must be valid
+	// even though no response fields are selected.
+	expr, err := search.ParseQuery("example")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = resolver.RequestLogService.SetRequestLogFilter(ctx, reqlog.FindRequestsFilter{SearchExpr: expr})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver}))
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{
+			name:  "request logs",
+			query: `{ httpRequestLogs { id method } }`,
+		},
+		{
+			name:  "request log by ID",
+			query: `{ httpRequestLog(id: 1) { id method } }`,
+		},
+		{
+			name:  "only ID",
+			query: `{ httpRequestLogs { id } }`,
+		},
+		{
+			name:  "only fields without column",
+			query: `{ httpRequestLogs { __typename relativeTime } }`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			body, err := json.Marshal(map[string]string{"query": tt.query})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/graphql/", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+
+			var resp struct {
+				Errors []interface{}
+			}
+
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("could not decode response: %v", err)
+			}
+
+			if len(resp.Errors) != 0 {
+				t.Errorf("unexpected errors for request log %v: %v", reqLog.ID, resp.Errors)
+			}
+		})
+	}
+}
+
+func TestHTTPRequestLogBodySizes(t *testing.T) {
+	t.Parallel()
+
+	resolver, db := newTestResolver(t)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/", nil)
+
+	reqLog, err := db.AddRequestLog(ctx, reqlog.Request{Request: *req, Body: []byte("foo"), Timestamp: time.Now()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res := http.Response{Status: "200 OK", StatusCode: http.StatusOK, Proto: "HTTP/1.1"}
+
+	if _, err := db.AddResponseLog(ctx, reqlog.Request{
+		ID:       reqLog.ID,
+		Response: &reqlog.Response{Response: res, Body: []byte("foobar"), Timestamp: time.Now()},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Request log without a response.
+	if _, err := db.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver}))
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{
+			name:  "sizes only",
+			query: `{ httpRequestLogs { id requestBodySize responseBodySize transferSize } }`,
+		},
+		{
+			name:  "sizes with response",
+			query: `{ httpRequestLogs { id requestBodySize responseBodySize transferSize response { statusCode } } }`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			body, err := json.Marshal(map[string]string{"query": tt.query})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/graphql/", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+
+			var resp struct {
+				Data struct {
+					HTTPRequestLogs []struct {
+						ID               int64
+						RequestBodySize  int
+						ResponseBodySize *int
+						TransferSize     int
+					}
+				}
+				Errors []interface{}
+			}
+
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("could not decode response: %v", err)
+			}
+
+			if len(resp.Errors) != 0 {
+				t.Fatalf("unexpected errors: %v", resp.Errors)
+			}
+
+			logs := resp.Data.HTTPRequestLogs
+			if len(logs) != 2 {
+				t.Fatalf("expected 2 request logs, got: %v", len(logs))
+			}
+
+			// Request logs are ordered by ID, descending.
+			if logs[0].RequestBodySize != 0 || logs[0].ResponseBodySize != nil || logs[0].TransferSize != 0 {
+				t.Errorf("unexpected sizes for request log without response: %+v", logs[0])
+			}
+
+			if logs[1].RequestBodySize != 3 {
+				t.Errorf("expected request body size 3, got: %v", logs[1].RequestBodySize)
+			}
+
+			if logs[1].ResponseBodySize == nil || *logs[1].ResponseBodySize != 6 {
+				t.Errorf("expected response body size 6, got: %v", logs[1].ResponseBodySize)
+			}
+
+			if logs[1].TransferSize != 9 {
+				t.Errorf("expected transfer size 9, got: %v", logs[1].TransferSize)
+			}
+		})
+	}
+}
+
+func TestHTTPRequestLogByID(t *testing.T) {
+	t.Parallel()
+
+	resolver, db := newTestResolver(t)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/foo", nil)
+
+	reqLog, err := db.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver}))
+
+	// IDs are integers in storage and in the API. As an input, an `ID` can be
+	// given as a string or a number.
+	tests := []struct {
+		name      string
+		query     string
+		variables map[string]interface{}
+	}{
+		{
+			name:  "ID as string",
+			query: fmt.Sprintf(`{ httpRequestLog(id: "%v") { id url } }`, reqLog.ID),
+		},
+		{
+			name:  "ID as number",
+			query: fmt.Sprintf(`{ httpRequestLog(id: %v) { id url } }`, reqLog.ID),
+		},
+		{
+			name:      "ID as variable",
+			query:     `query ($id: ID!) { httpRequestLog(id: $id) { id url } }`,
+			variables: map[string]interface{}{"id": strconv.FormatInt(reqLog.ID, 10)},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			body, err := json.Marshal(map[string]interface{}{"query": tt.query, "variables": tt.variables})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/graphql/", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+
+			var resp struct {
+				Data struct {
+					HTTPRequestLog *struct {
+						ID  int64
+						URL string
+					}
+				}
+				Errors []interface{}
+			}
+
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("could not decode response: %v", err)
+			}
+
+			if len(resp.Errors) != 0 {
+				t.Fatalf("unexpected errors: %v", resp.Errors)
+			}
+
+			got := resp.Data.HTTPRequestLog
+			if got == nil {
+				t.Fatal("expected request log, got: nil")
+			}
+
+			if got.ID != reqLog.ID {
+				t.Errorf("expected ID %v, got: %v", reqLog.ID, got.ID)
+			}
+
+			if exp := "https://example.com/foo"; got.URL != exp {
+				t.Errorf("expected URL %q, got: %q", exp, got.URL)
+			}
+		})
+	}
+}
+
+func TestHTTPRequestLogsByIDs(t *testing.T) {
+	t.Parallel()
+
+	resolver, db := newTestResolver(t)
+	ctx := context.Background()
+
+	var ids []int64
+
+	for _, path := range []string{"/foo", "/bar", "/baz"} {
+		req := httptest.NewRequest(http.MethodGet, "https://example.com"+path, nil)
+		req.Header.Set("X-Path", path)
+
+		reqLog, err := db.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		res := http.Response{
+			StatusCode: http.StatusOK,
+			Proto:      "HTTP/1.1",
+			Header:     http.Header{"X-Path": []string{path}},
+		}
+
+		if _, err := db.AddResponseLog(ctx, reqlog.Request{
+			ID:       reqLog.ID,
+			Response: &reqlog.Response{Response: res, Timestamp: time.Now()},
+		}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		ids = append(ids, reqLog.ID)
+	}
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver}))
+
+	// The IDs are out of insertion order, and include an unknown ID.
+	query := fmt.Sprintf(`{
+		httpRequestLogsByIDs(ids: [%v, 9999, %v]) {
+			id
+			url
+			headers { key value }
+			response { headers { key value } }
+		}
+	}`, ids[2], ids[0])
+
+	body, err := json.Marshal(map[string]string{"query": query})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/graphql/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+
+	type header struct{ Key, Value string }
+
+	var resp struct {
+		Data struct {
+			HTTPRequestLogsByIDs []struct {
+				ID       int64
+				URL      string
+				Headers  []header
+				Response struct {
+					Headers []header
+				}
+			}
+		}
+		Errors []interface{}
+	}
+
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+
+	if len(resp.Errors) != 0 {
+		t.Fatalf("unexpected errors: %v", resp.Errors)
+	}
+
+	got := resp.Data.HTTPRequestLogsByIDs
+	if len(got) != 2 {
+		t.Fatalf("expected 2 request logs, got: %v", len(got))
+	}
+
+	for i, exp := range []struct {
+		id   int64
+		path string
+	}{{id: ids[2], path: "/baz"}, {id: ids[0], path: "/foo"}} {
+		if got[i].ID != exp.id {
+			t.Errorf("expected request log %v to have ID %v, got: %v", i, exp.id, got[i].ID)
+		}
+
+		if expURL := "https://example.com" + exp.path; got[i].URL != expURL {
+			t.Errorf("expected URL %q, got: %q", expURL, got[i].URL)
+		}
+
+		expHeaders := []header{{Key: "X-Path", Value: exp.path}}
+
+		if !reflect.DeepEqual(got[i].Headers, expHeaders) {
+			t.Errorf("expected request headers %v, got: %v", expHeaders, got[i].Headers)
+		}
+
+		if !reflect.DeepEqual(got[i].Response.Headers, expHeaders) {
+			t.Errorf("expected response headers %v, got: %v", expHeaders, got[i].Response.Headers)
+		}
+	}
+}
+
+func TestHTTPRequestLogsResponseBodyLimit(t *testing.T) {
+	t.Parallel()
+
+	resolver, db := newTestResolver(t)
+	resolver.MaxListBodyRows = 500
+	ctx := context.Background()
+
+	err := db.WithTx(ctx, func(tx reqlog.Repository) error {
+		for i := 0; i < 1000; i++ {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("https://example.com/%v", i), nil)
+
+			reqLog, err := tx.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+			if err != nil {
+				return err
+			}
+
+			res := http.Response{StatusCode: http.StatusOK, Proto: "HTTP/1.1"}
+
+			if _, err := tx.AddResponseLog(ctx, reqlog.Request{
+				ID:       reqLog.ID,
+				Response: &reqlog.Response{Response: res, Body: []byte("foobar"), Timestamp: time.Now()},
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver}))
+
+	tests := []struct {
+		name       string
+		query      string
+		filter     string
+		expErrCode string
+		expLen     int
+	}{
+		{
+			name:       "body of all request logs",
+			query:      "id response { body }",
+			expErrCode: ErrCodeTooManyBodies,
+		},
+		{
+			name:       "body type of all request logs",
+			query:      "id response { bodyType }",
+			expErrCode: ErrCodeTooManyBodies,
+		},
+		{
+			name:   "without body",
+			query:  "id response { statusCode }",
+			expLen: 1000,
+		},
+		{
+			name:   "body within limit",
+			query:  "id response { body }",
+			filter: "path:/99",
+			expLen: 11,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			query := fmt.Sprintf("{ httpRequestLogs { %v } }", tt.query)
+			if tt.filter != "" {
+				query = fmt.Sprintf("{ httpRequestLogs(filter: %q) { %v } }", tt.filter, tt.query)
+			}
+
+			body, err := json.Marshal(map[string]string{"query": query})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/graphql/", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+
+			var resp struct {
+				Data *struct {
+					HTTPRequestLogs []struct {
+						ID int64
+					}
+				}
+				Errors []struct {
+					Message    string
+					Extensions map[string]interface{}
+				}
+			}
+
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("could not decode response: %v", err)
+			}
+
+			if tt.expErrCode != "" {
+				if len(resp.Errors) != 1 {
+					t.Fatalf("expected 1 error, got: %v", resp.Errors)
+				}
+
+				if code := resp.Errors[0].Extensions["code"]; code != tt.expErrCode {
+					t.Errorf("expected error code %q, got: %v", tt.expErrCode, code)
+				}
+
+				if resp.Data != nil {
+					t.Errorf("expected no data, got: %+v", resp.Data)
+				}
+
+				return
+			}
+
+			if len(resp.Errors) != 0 {
+				t.Fatalf("unexpected errors: %v", resp.Errors)
+			}
+
+			if got := len(resp.Data.HTTPRequestLogs); got != tt.expLen {
+				t.Errorf("expected %v request logs, got: %v", tt.expLen, got)
+			}
+		})
+	}
+}
+
+func TestHTTPRequestLogBodyHex(t *testing.T) {
+	t.Parallel()
+
+	resolver, db := newTestResolver(t)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/", nil)
+
+	reqLog, err := db.AddRequestLog(ctx, reqlog.Request{
+		Request:   *req,
+		Body:      []byte{0x00, 0x01, 0xfe, 0xff, 'f', 'o', 'o'},
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res := http.Response{Status: "200 OK", StatusCode: http.StatusOK, Proto: "HTTP/1.1"}
+
+	if _, err := db.AddResponseLog(ctx, reqlog.Request{
+		ID:       reqLog.ID,
+		Response: &reqlog.Response{Response: res, Body: []byte("0123456789abcdefXYZ"), Timestamp: time.Now()},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver}))
+
+	tests := []struct {
+		name       string
+		query      string
+		expReqHex  string
+		expResHex  string
+		expResBody bool
+	}{
+		{
+			name:      "full",
+			query:     `{ httpRequestLogs { bodyHex response { bodyHex } } }`,
+			expReqHex: "00000000  00 01 fe ff 66 6f 6f                              |....foo|\n",
+			expResHex: "00000000  30 31 32 33 34 35 36 37  38 39 61 62 63 64 65 66  |0123456789abcdef|\n" +
+				"00000010  58 59 5a                                          |XYZ|\n",
+		},
+		{
+			name:       "limited, with body",
+			query:      `{ httpRequestLogs { bodyHex(limit: 2) response { body bodyHex(limit: 3) } } }`,
+			expReqHex:  "00000000  00 01                                             |..|\n",
+			expResHex:  "00000000  30 31 32                                          |012|\n",
+			expResBody: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			body, err := json.Marshal(map[string]string{"query": tt.query})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/graphql/", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+
+			var resp struct {
+				Data struct {
+					HTTPRequestLogs []struct {
+						BodyHex  string
+						Response struct {
+							Body    *string
+							BodyHex string
+						}
+					}
+				}
+				Errors []interface{}
+			}
+
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("could not decode response: %v", err)
+			}
+
+			if len(resp.Errors) != 0 {
+				t.Fatalf("unexpected errors: %v", resp.Errors)
+			}
+
+			if len(resp.Data.HTTPRequestLogs) != 1 {
+				t.Fatalf("expected 1 request log, got: %v", len(resp.Data.HTTPRequestLogs))
+			}
+
+			got := resp.Data.HTTPRequestLogs[0]
+
+			if got.BodyHex != tt.expReqHex {
+				t.Errorf("expected request body hex dump %q, got: %q", tt.expReqHex, got.BodyHex)
+			}
+
+			if got.Response.BodyHex != tt.expResHex {
+				t.Errorf("expected response body hex dump %q, got: %q", tt.expResHex, got.Response.BodyHex)
+			}
+
+			if tt.expResBody && (got.Response.Body == nil || *got.Response.Body != "0123456789abcdefXYZ") {
+				t.Errorf("expected response body to be returned, got: %v", got.Response.Body)
+			}
+		})
+	}
+}
+
+func TestHTTPRequestLogRaw(t *testing.T) {
+	t.Parallel()
+
+	resolver, db := newTestResolver(t)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/foo?bar=baz", nil)
+	req.Header.Set("Content-Type", "text/plain")
+
+	reqLog, err := db.AddRequestLog(ctx, reqlog.Request{Request: *req, Body: []byte("foo"), Timestamp: time.Now()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res := http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		Header:     http.Header{"X-Foo": []string{"bar"}},
+	}
+
+	if _, err := db.AddResponseLog(ctx, reqlog.Request{
+		ID:       reqLog.ID,
+		Response: &reqlog.Response{Response: res, Body: []byte("foobar"), Timestamp: time.Now()},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	withoutRes, err := db.AddRequestLog(ctx, reqlog.Request{
+		Request:   *httptest.NewRequest(http.MethodGet, "https://example.com/", nil),
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver}))
+
+	tests := []struct {
+		name           string
+		id             int64
+		expRequestRaw  string
+		expResponseRaw *string
+	}{
+		{
+			name:          "with response",
+			id:            reqLog.ID,
+			expRequestRaw: "POST /foo?bar=baz HTTP/1.1\r\nHost: example.com\r\nContent-Type: text/plain\r\n\r\nfoo",
+			expResponseRaw: func() *string {
+				s := "HTTP/1.1 200 OK\r\nX-Foo: bar\r\n\r\nfoobar"
+				return &s
+			}(),
+		},
+		{
+			name:          "without response",
+			id:            withoutRes.ID,
+			expRequestRaw: "GET / HTTP/1.1\r\nHost: example.com\r\n\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			query := fmt.Sprintf(`{ httpRequestLogRaw(id: %v) { requestRaw responseRaw } }`, tt.id)
+
+			body, err := json.Marshal(map[string]string{"query": query})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/graphql/", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+
+			var resp struct {
+				Data struct {
+					HTTPRequestLogRaw *struct {
+						RequestRaw  string
+						ResponseRaw *string
+					}
+				}
+				Errors []interface{}
+			}
+
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("could not decode response: %v", err)
+			}
+
+			if len(resp.Errors) != 0 {
+				t.Fatalf("unexpected errors: %v", resp.Errors)
+			}
+
+			got := resp.Data.HTTPRequestLogRaw
+			if got == nil {
+				t.Fatal("expected raw request log, got: nil")
+			}
+
+			if got.RequestRaw != tt.expRequestRaw {
+				t.Errorf("expected raw request %q, got: %q", tt.expRequestRaw, got.RequestRaw)
+			}
+
+			switch {
+			case tt.expResponseRaw == nil && got.ResponseRaw != nil:
+				t.Errorf("expected raw response to be nil, got: %q", *got.ResponseRaw)
+			case tt.expResponseRaw != nil && got.ResponseRaw == nil:
+				t.Errorf("expected raw response %q, got: nil", *tt.expResponseRaw)
+			case tt.expResponseRaw != nil && *got.ResponseRaw != *tt.expResponseRaw:
+				t.Errorf("expected raw response %q, got: %q", *tt.expResponseRaw, *got.ResponseRaw)
+			}
+		})
+	}
+}
+
+func TestHTTPRequestLogsMatches(t *testing.T) {
+	t.Parallel()
+
+	resolver, db := newTestResolver(t)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/", nil)
+
+	reqLog, err := db.AddRequestLog(ctx, reqlog.Request{Request: *req, Body: []byte("foo bar foo"), Timestamp: time.Now()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res := http.Response{Status: "200 OK", StatusCode: http.StatusOK, Proto: "HTTP/1.1"}
+
+	if _, err := db.AddResponseLog(ctx, reqlog.Request{
+		ID:       reqLog.ID,
+		Response: &reqlog.Response{Response: res, Body: []byte("no match"), Timestamp: time.Now()},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expr, err := search.ParseQuery("foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = resolver.RequestLogService.SetRequestLogFilter(ctx, reqlog.FindRequestsFilter{SearchExpr: expr})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver}))
+
+	body, err := json.Marshal(map[string]string{
+		"query": `{ httpRequestLogs { matches { start length } response { matches { start length } } } }`,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	httpReq := httptest.NewRequest(http.MethodPost, "/api/graphql/", bytes.NewReader(body))
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, httpReq)
+
+	type matchRange struct {
+		Start  int
+		Length int
+	}
+
+	var resp struct {
+		Data struct {
+			HTTPRequestLogs []struct {
+				Matches  []matchRange
+				Response struct {
+					Matches []matchRange
+				}
+			}
+		}
+		Errors []interface{}
+	}
+
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+
+	if len(resp.Errors) != 0 {
+		t.Fatalf("unexpected errors: %v", resp.Errors)
+	}
+
+	if len(resp.Data.HTTPRequestLogs) != 1 {
+		t.Fatalf("expected 1 request log, got: %v", len(resp.Data.HTTPRequestLogs))
+	}
+
+	got := resp.Data.HTTPRequestLogs[0]
+
+	if exp := []matchRange{{Start: 0, Length: 3}, {Start: 8, Length: 3}}; !reflect.DeepEqual(got.Matches, exp) {
+		t.Errorf("expected request body matches %v, got: %v", exp, got.Matches)
+	}
+
+	if got.Response.Matches == nil || len(got.Response.Matches) != 0 {
+		t.Errorf("expected empty response body matches, got: %v", got.Response.Matches)
+	}
+}
+
+func TestHTTPResponseLogHeadersConnection(t *testing.T) {
+	t.Parallel()
+
+	resolver, db := newTestResolver(t)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+	reqLog, err := db.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res := http.Response{Status: "200 OK", StatusCode: http.StatusOK, Proto: "HTTP/1.1", Header: make(http.Header)}
+
+	for i := 0; i < 200; i++ {
+		res.Header.Set(fmt.Sprintf("X-Header-%03d", i), strconv.Itoa(i))
+	}
+
+	if _, err := db.AddResponseLog(ctx, reqlog.Request{
+		ID:       reqLog.ID,
+		Response: &reqlog.Response{Response: res, Timestamp: time.Now()},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver}))
+
+	type page struct {
+		Edges []struct {
+			Cursor string
+			Node   struct {
+				Key   string
+				Value string
+			}
+		}
+		PageInfo struct {
+			HasNextPage bool
+			EndCursor   *string
+		}
+		TotalCount int
+	}
+
+	query := func(t *testing.T, args string) page {
+		t.Helper()
+
+		body, err := json.Marshal(map[string]string{
+			"query": fmt.Sprintf(`{ httpRequestLog(id: %v) { response { headersConnection%v {
+				edges { cursor node { key value } } pageInfo { hasNextPage endCursor } totalCount
+			} } } }`, reqLog.ID, args),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/graphql/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, req)
+
+		var resp struct {
+			Data struct {
+				HTTPRequestLog struct {
+					Response struct {
+						HeadersConnection page
+					}
+				}
+			}
+			Errors []interface{}
+		}
+
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("could not decode response: %v", err)
+		}
+
+		if len(resp.Errors) != 0 {
+			t.Fatalf("unexpected errors: %v", resp.Errors)
+		}
+
+		return resp.Data.HTTPRequestLog.Response.HeadersConnection
+	}
+
+	t.Run("paging", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			keys  []string
+			after string
+			pages int
+		)
+
+		for {
+			args := "(first: 64)"
+			if after != "" {
+				args = fmt.Sprintf("(first: 64, after: %q)", after)
+			}
+
+			p := query(t, args)
+			pages++
+
+			if p.TotalCount != 200 {
+				t.Fatalf("expected total count 200, got: %v", p.TotalCount)
+			}
+
+			for _, edge := range p.Edges {
+				keys = append(keys, edge.Node.Key)
+			}
+
+			if !p.PageInfo.HasNextPage {
+				break
+			}
+
+			if p.PageInfo.EndCursor == nil {
+				t.Fatal("expected end cursor")
+			}
+
+			after = *p.PageInfo.EndCursor
+		}
+
+		if pages != 4 {
+			t.Errorf("expected 4 pages, got: %v", pages)
+		}
+
+		if len(keys) != 200 {
+			t.Fatalf("expected 200 headers, got: %v", len(keys))
+		}
+
+		for i, key := range keys {
+			if exp := fmt.Sprintf("X-Header-%03d", i); key != exp {
+				t.Fatalf("expected header %v to be %q, got: %q", i, exp, key)
+			}
+		}
+	})
+
+	t.Run("without pagination arguments", func(t *testing.T) {
+		t.Parallel()
+
+		p := query(t, "")
+
+		if len(p.Edges) != 200 {
+			t.Errorf("expected all 200 headers, got: %v", len(p.Edges))
+		}
+
+		if p.PageInfo.HasNextPage {
+			t.Error("expected no next page")
+		}
+	})
+}
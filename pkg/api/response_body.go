@@ -0,0 +1,53 @@
+package api
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// ResponseBodyHandler returns a handler that streams the stored response body
+// of a request log, identified by the `id` route variable. Range requests are
+// supported, for fetching parts of large bodies.
+func ResponseBodyHandler(svc *reqlog.Service) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid ID.", http.StatusBadRequest)
+			return
+		}
+
+		resBody, err := svc.ResponseBody(r.Context(), id)
+		switch {
+		case errors.Is(err, proj.ErrNoProject):
+			http.Error(w, "No active project.", http.StatusBadRequest)
+			return
+		case errors.Is(err, reqlog.ErrResponseNotFound):
+			http.Error(w, "Response not found.", http.StatusNotFound)
+			return
+		case err != nil:
+			log.Printf("[ERROR] Could not find response body: %v", err)
+			http.Error(w, "Internal server error.", http.StatusInternalServerError)
+			return
+		}
+
+		if contentType := resBody.Header.Get("Content-Type"); contentType != "" {
+			w.Header().Set("Content-Type", contentType)
+		}
+
+		// Gzip encoded response bodies are decoded before they're stored.
+		if contentEncoding := resBody.Header.Get("Content-Encoding"); contentEncoding != "" &&
+			!strings.EqualFold(contentEncoding, "gzip") {
+			w.Header().Set("Content-Encoding", contentEncoding)
+		}
+
+		http.ServeContent(w, r, "", resBody.Timestamp, resBody.Body)
+	})
+}
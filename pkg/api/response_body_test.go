@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestResponseBodyHandler(t *testing.T) {
+	t.Parallel()
+
+	resolver, db := newTestResolver(t)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+	reqLog, err := db.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res := http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/plain"}},
+	}
+
+	if _, err := db.AddResponseLog(ctx, reqlog.Request{
+		ID:       reqLog.ID,
+		Response: &reqlog.Response{Response: res, Body: []byte("0123456789"), Timestamp: time.Now()},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	router := mux.NewRouter()
+	router.Path("/api/logs/{id}/response-body").Handler(ResponseBodyHandler(resolver.RequestLogService))
+
+	tests := []struct {
+		name           string
+		id             int64
+		rangeHeader    string
+		expStatus      int
+		expBody        string
+		expContentType string
+	}{
+		{
+			name:           "full body",
+			id:             reqLog.ID,
+			expStatus:      http.StatusOK,
+			expBody:        "0123456789",
+			expContentType: "text/plain",
+		},
+		{
+			name:           "range",
+			id:             reqLog.ID,
+			rangeHeader:    "bytes=2-5",
+			expStatus:      http.StatusPartialContent,
+			expBody:        "2345",
+			expContentType: "text/plain",
+		},
+		{
+			name:        "suffix range",
+			id:          reqLog.ID,
+			rangeHeader: "bytes=-3",
+			expStatus:   http.StatusPartialContent,
+			expBody:     "789",
+		},
+		{
+			name:      "response not found",
+			id:        reqLog.ID + 1,
+			expStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/logs/%v/response-body", tt.id), nil)
+			if tt.rangeHeader != "" {
+				req.Header.Set("Range", tt.rangeHeader)
+			}
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.expStatus {
+				t.Fatalf("expected status %v, got: %v", tt.expStatus, rec.Code)
+			}
+
+			if tt.expBody != "" && rec.Body.String() != tt.expBody {
+				t.Errorf("expected body %q, got: %q", tt.expBody, rec.Body.String())
+			}
+
+			if tt.expContentType != "" && rec.Header().Get("Content-Type") != tt.expContentType {
+				t.Errorf("expected content type %q, got: %q", tt.expContentType, rec.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+func TestHTTPRequestLogsSavedSearch(t *testing.T) {
+	t.Parallel()
+
+	resolver, db := newTestResolver(t)
+	ctx := context.Background()
+
+	for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodPost} {
+		addTestRequestLog(t, db, reqlog.Request{Request: *httptest.NewRequest(method, "https://example.com/", nil)})
+	}
+
+	expr, err := search.ParseQuery("req.method = POST")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	saved, err := resolver.RequestLogService.SaveSearch(ctx, "Only POST", reqlog.FindRequestsFilter{
+		SearchExpr:    expr,
+		RawSearchExpr: "req.method = POST",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver}))
+	srv.SetErrorPresenter(ErrorPresenter)
+
+	tests := []struct {
+		name       string
+		query      string
+		expMethods []string
+		expErrCode string
+	}{
+		{
+			name:       "saved search",
+			query:      fmt.Sprintf(`{ httpRequestLogs(savedSearchId: %v) { method } }`, saved.ID),
+			expMethods: []string{http.MethodPost, http.MethodPost},
+		},
+		{
+			name:       "unknown saved search",
+			query:      fmt.Sprintf(`{ httpRequestLogs(savedSearchId: %v) { method } }`, saved.ID+1),
+			expErrCode: ErrCodeNotFound,
+		},
+		{
+			name:       "saved search and filter",
+			query:      fmt.Sprintf(`{ httpRequestLogs(savedSearchId: %v, filter: "GET") { method } }`, saved.ID),
+			expErrCode: ErrCodeBadInput,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			body, err := json.Marshal(map[string]string{"query": tt.query})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/graphql/", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+
+			var resp struct {
+				Data struct {
+					HTTPRequestLogs []struct {
+						Method string
+					}
+				}
+				Errors []struct {
+					Extensions map[string]interface{}
+				}
+			}
+
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("could not decode response: %v", err)
+			}
+
+			if tt.expErrCode != "" {
+				if len(resp.Errors) != 1 {
+					t.Fatalf("expected 1 error, got: %v", len(resp.Errors))
+				}
+
+				if code := resp.Errors[0].Extensions["code"]; code != tt.expErrCode {
+					t.Errorf("expected error code %q, got: %v", tt.expErrCode, code)
+				}
+
+				return
+			}
+
+			if len(resp.Errors) != 0 {
+				t.Fatalf("unexpected errors: %v", resp.Errors)
+			}
+
+			var methods []string
+			for _, reqLog := range resp.Data.HTTPRequestLogs {
+				methods = append(methods, reqLog.Method)
+			}
+
+			if fmt.Sprint(methods) != fmt.Sprint(tt.expMethods) {
+				t.Errorf("expected methods %v, got: %v", tt.expMethods, methods)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+const defaultServerAddr = "localhost:8081"
+
+// ServerConfig configures a standalone API server, which listens separately
+// from the proxy.
+type ServerConfig struct {
+	// Addr is the TCP address to listen on. Defaults to "localhost:8081", so
+	// the API isn't reachable from other hosts unless configured otherwise.
+	Addr string
+	// CertFile and KeyFile are paths to a PEM encoded certificate and private
+	// key. When both are set, the server uses TLS.
+	CertFile string
+	KeyFile  string
+}
+
+// Server is a standalone API server.
+type Server struct {
+	srv      *http.Server
+	listener net.Listener
+	// tls is set once, because the HTTP server writes its TLS config when it
+	// starts serving.
+	tls bool
+}
+
+// NewServer validates the TLS key pair (if any), and binds the configured
+// address. Call Serve to start handling requests.
+func NewServer(cfg ServerConfig, handler http.Handler) (*Server, error) {
+	if cfg.Addr == "" {
+		cfg.Addr = defaultServerAddr
+	}
+
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return nil, errors.New("api: both certificate and private key files must be set for TLS")
+	}
+
+	var tlsConfig *tls.Config
+
+	if cfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("api: could not load TLS key pair: %w", err)
+		}
+
+		tlsConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
+	}
+
+	l, err := net.Listen("tcp", cfg.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("api: could not listen on %v: %w", cfg.Addr, err)
+	}
+
+	if tlsConfig != nil {
+		l = tls.NewListener(l, tlsConfig)
+	}
+
+	return &Server{
+		srv:      &http.Server{Handler: handler, TLSConfig: tlsConfig},
+		listener: l,
+		tls:      tlsConfig != nil,
+	}, nil
+}
+
+// Addr returns the address the server listens on.
+func (s *Server) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// TLS returns true if the server uses TLS.
+func (s *Server) TLS() bool {
+	return s.tls
+}
+
+// Serve handles requests until the server is closed. It always returns a
+// non-nil error; http.ErrServerClosed after Close.
+func (s *Server) Serve() error {
+	return s.srv.Serve(s.listener)
+}
+
+// Close closes the listener and all connections.
+func (s *Server) Close() error {
+	err := s.srv.Close()
+
+	// The listener is only closed by the HTTP server once it's serving.
+	if lErr := s.listener.Close(); err == nil && lErr != nil && !errors.Is(lErr, net.ErrClosed) {
+		err = lErr
+	}
+
+	return err
+}
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeTestKeyPair writes a self-signed certificate for 127.0.0.1 and its
+// private key to dir.
+func writeTestKeyPair(t *testing.T, dir string) (certFile, keyFile string, cert *x509.Certificate) {
+	t.Helper()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("could not generate private key: %v", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &priv.PublicKey, priv)
+	if err != nil {
+		t.Fatalf("could not create certificate: %v", err)
+	}
+
+	cert, err = x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("could not parse certificate: %v", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatalf("could not marshal private key: %v", err)
+	}
+
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatalf("could not write certificate: %v", err)
+	}
+
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatalf("could not write private key: %v", err)
+	}
+
+	return certFile, keyFile, cert
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello"))
+	})
+
+	certFile, keyFile, cert := writeTestKeyPair(t, t.TempDir())
+
+	t.Run("binds configured address", func(t *testing.T) {
+		t.Parallel()
+
+		srv, err := NewServer(ServerConfig{Addr: "127.0.0.1:0"}, handler)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer srv.Close()
+
+		go srv.Serve()
+
+		host, _, _ := net.SplitHostPort(srv.Addr().String())
+		if host != "127.0.0.1" {
+			t.Errorf("expected server to listen on 127.0.0.1, got: %v", host)
+		}
+
+		if srv.TLS() {
+			t.Error("expected server not to use TLS")
+		}
+
+		res, err := http.Get("http://" + srv.Addr().String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode != http.StatusOK {
+			t.Errorf("expected status %v, got: %v", http.StatusOK, res.StatusCode)
+		}
+	})
+
+	t.Run("serves over TLS", func(t *testing.T) {
+		t.Parallel()
+
+		srv, err := NewServer(ServerConfig{Addr: "127.0.0.1:0", CertFile: certFile, KeyFile: keyFile}, handler)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer srv.Close()
+
+		go srv.Serve()
+
+		if !srv.TLS() {
+			t.Error("expected server to use TLS")
+		}
+
+		pool := x509.NewCertPool()
+		pool.AddCert(cert)
+
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}}
+
+		res, err := client.Get("https://" + srv.Addr().String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer res.Body.Close()
+
+		body, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if string(body) != "hello" {
+			t.Errorf("expected body %q, got: %q", "hello", body)
+		}
+
+		if res.TLS == nil {
+			t.Error("expected response to be served over TLS")
+		}
+	})
+
+	t.Run("invalid key pair", func(t *testing.T) {
+		t.Parallel()
+
+		otherCertFile, _, _ := writeTestKeyPair(t, t.TempDir())
+
+		_, err := NewServer(ServerConfig{Addr: "127.0.0.1:0", CertFile: otherCertFile, KeyFile: keyFile}, handler)
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("certificate without key", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewServer(ServerConfig{Addr: "127.0.0.1:0", CertFile: certFile}, handler)
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
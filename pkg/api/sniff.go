@@ -0,0 +1,141 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// SniffRule maps bodies with magic bytes, or requests with a URL path with an
+// extension, to a content type, e.g. for proprietary formats that
+// `http.DetectContentType` doesn't know.
+type SniffRule struct {
+	ContentType string `json:"contentType"`
+	// Magic is the hex encoded bytes that a body must have at Offset, e.g.
+	// "4d5a" for Windows executables.
+	Magic  string `json:"magic"`
+	Offset int    `json:"offset"`
+	// Extensions (e.g. ".foo") are matched against the path of the request
+	// URL, case-insensitive. They're only consulted if no rule matches the
+	// magic bytes of the body.
+	Extensions []string `json:"extensions"`
+
+	magic []byte
+}
+
+// LoadSniffRules reads sniff rules from a JSON file, with an array of rules.
+func LoadSniffRules(file string) ([]SniffRule, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("api: could not read sniff rules: %w", err)
+	}
+
+	var rules []SniffRule
+
+	if err := json.Unmarshal(b, &rules); err != nil {
+		return nil, fmt.Errorf("api: could not parse sniff rules: %w", err)
+	}
+
+	for i, rule := range rules {
+		if rule.ContentType == "" {
+			return nil, fmt.Errorf("api: sniff rule %v has no content type", i)
+		}
+
+		if rule.Magic == "" && len(rule.Extensions) == 0 {
+			return nil, fmt.Errorf("api: sniff rule %v has no magic bytes or extensions", i)
+		}
+
+		if rule.Offset < 0 {
+			return nil, fmt.Errorf("api: sniff rule %v has a negative offset", i)
+		}
+
+		if rules[i].magic, err = hex.DecodeString(rule.Magic); err != nil {
+			return nil, fmt.Errorf("api: sniff rule %v has invalid magic bytes: %w", i, err)
+		}
+	}
+
+	return rules, nil
+}
+
+// sniffContentType returns the content type of a body, using the first rule
+// that matches its magic bytes, then the first rule that matches the extension
+// of the URL path. It falls back to `http.DetectContentType`.
+func sniffContentType(rules []SniffRule, body []byte, rawURL string) string {
+	for _, rule := range rules {
+		magic := rule.magic
+		if magic == nil && rule.Magic != "" {
+			magic, _ = hex.DecodeString(rule.Magic)
+		}
+
+		if len(magic) == 0 || rule.Offset < 0 || len(body) < rule.Offset+len(magic) {
+			continue
+		}
+
+		if bytes.Equal(body[rule.Offset:rule.Offset+len(magic)], magic) {
+			return rule.ContentType
+		}
+	}
+
+	if u, err := url.Parse(rawURL); err == nil && rawURL != "" {
+		ext := path.Ext(u.Path)
+
+		for _, rule := range rules {
+			for _, ruleExt := range rule.Extensions {
+				if ext != "" && strings.EqualFold(ext, ruleExt) {
+					return rule.ContentType
+				}
+			}
+		}
+	}
+
+	return http.DetectContentType(body)
+}
+
+func (r *httpRequestLogResolver) BodyType(ctx context.Context, obj *HTTPRequestLog) (*string, error) {
+	if obj.Body == nil {
+		return nil, nil
+	}
+
+	contentType := sniffContentType(r.SniffRules, []byte(*obj.Body), obj.URL)
+
+	return &contentType, nil
+}
+
+func (r *httpResponseLogResolver) BodyType(ctx context.Context, obj *HTTPResponseLog) (*string, error) {
+	if obj.Body == nil {
+		return nil, nil
+	}
+
+	var rawURL string
+	if reqLog := parentRequestLog(ctx); reqLog != nil {
+		rawURL = reqLog.URL
+	}
+
+	contentType := sniffContentType(r.SniffRules, []byte(*obj.Body), rawURL)
+
+	return &contentType, nil
+}
+
+// parentRequestLog returns the request log that a response log field is
+// resolved for, or nil if it isn't known.
+func parentRequestLog(ctx context.Context) *HTTPRequestLog {
+	for fc := graphql.GetFieldContext(ctx); fc != nil; fc = fc.Parent {
+		switch v := fc.Result.(type) {
+		case *HTTPRequestLog:
+			return v
+		case HTTPRequestLog:
+			return &v
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestBodyType(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "sniff_rules.json")
+
+	err := ioutil.WriteFile(file, []byte(`[
+		{"contentType": "application/x-custom", "magic": "2d31", "offset": 4},
+		{"contentType": "application/x-foo", "extensions": [".FOO"]}
+	]`), 0600)
+	if err != nil {
+		t.Fatalf("could not write sniff rules: %v", err)
+	}
+
+	rules, err := LoadSniffRules(file)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resolver, db := newTestResolver(t)
+	resolver.SniffRules = rules
+	addReqLog := func(url string, reqBody, resBody []byte) int64 {
+		res := http.Response{StatusCode: http.StatusOK, Proto: "HTTP/1.1", Header: http.Header{}}
+
+		return addTestRequestLog(t, db, reqlog.Request{
+			Request:  *httptest.NewRequest(http.MethodPost, url, nil),
+			Body:     reqBody,
+			Response: &reqlog.Response{Response: res, Body: resBody},
+		}).ID
+	}
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver}))
+
+	tests := []struct {
+		name       string
+		url        string
+		reqBody    []byte
+		resBody    []byte
+		expReqType string
+		expResType string
+	}{
+		{
+			// Without a rule, this would be detected as "application/pdf".
+			name:       "magic bytes rule over default detection",
+			url:        "https://example.com/doc.pdf",
+			reqBody:    []byte("%PDF-1.7"),
+			resBody:    []byte("%PDF-1.7"),
+			expReqType: "application/x-custom",
+			expResType: "application/x-custom",
+		},
+		{
+			name:       "extension rule",
+			url:        "https://example.com/data.foo?bar=baz",
+			reqBody:    []byte("hello"),
+			resBody:    []byte{0x00, 0x01},
+			expReqType: "application/x-foo",
+			expResType: "application/x-foo",
+		},
+		{
+			name:       "default detection",
+			url:        "https://example.com/",
+			reqBody:    []byte("hello"),
+			resBody:    []byte("%PDF-2.0"),
+			expReqType: "text/plain; charset=utf-8",
+			expResType: "application/pdf",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			id := addReqLog(tt.url, tt.reqBody, tt.resBody)
+			query := fmt.Sprintf(`{ httpRequestLog(id: %v) { bodyType response { bodyType } } }`, id)
+
+			body, err := json.Marshal(map[string]string{"query": query})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/graphql/", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+
+			var resp struct {
+				Data struct {
+					HTTPRequestLog struct {
+						BodyType string
+						Response struct {
+							BodyType string
+						}
+					}
+				}
+				Errors []interface{}
+			}
+
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("could not decode response: %v", err)
+			}
+
+			if len(resp.Errors) != 0 {
+				t.Fatalf("unexpected errors: %v", resp.Errors)
+			}
+
+			if got := resp.Data.HTTPRequestLog.BodyType; got != tt.expReqType {
+				t.Errorf("expected request body type %q, got: %q", tt.expReqType, got)
+			}
+
+			if got := resp.Data.HTTPRequestLog.Response.BodyType; got != tt.expResType {
+				t.Errorf("expected response body type %q, got: %q", tt.expResType, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func (r *mutationResolver) StarHTTPRequestLog(ctx context.Context, id int64, starred bool) (*HTTPRequestLog, error) {
+	err := r.RequestLogService.SetStarred(ctx, id, starred)
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, reqlog.ErrRequestNotFound):
+		return nil, notFoundErr(ctx, "Request log not found.")
+	case err != nil:
+		return nil, fmt.Errorf("could not star request log: %w", err)
+	}
+
+	reqLog, err := r.RequestLogService.FindRequestLogByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("could not get request by ID: %w", err)
+	}
+
+	log := parseRequestLog(reqLog)
+
+	return &log, nil
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestStarHTTPRequestLog(t *testing.T) {
+	t.Parallel()
+
+	resolver, db := newTestResolver(t)
+	ctx := context.Background()
+
+	ids := make(map[string]int64)
+
+	for _, path := range []string{"/foo", "/bar", "/baz"} {
+		req := httptest.NewRequest(http.MethodGet, "https://example.com"+path, nil)
+		ids[path] = addTestRequestLog(t, db, reqlog.Request{Request: *req}).ID
+	}
+
+	for _, path := range []string{"/foo", "/bar"} {
+		log, err := resolver.Mutation().StarHTTPRequestLog(ctx, ids[path], true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !log.Starred {
+			t.Errorf("expected request log %v to be starred", path)
+		}
+	}
+
+	log, err := resolver.Mutation().StarHTTPRequestLog(ctx, ids["/bar"], false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if log.Starred {
+		t.Error("expected request log /bar to be unstarred")
+	}
+
+	tests := []struct {
+		filter  string
+		expURLs []string
+	}{
+		{filter: "starred:true", expURLs: []string{"https://example.com/foo"}},
+		{filter: "starred:false", expURLs: []string{"https://example.com/bar", "https://example.com/baz"}},
+	}
+
+	for _, tt := range tests {
+		filter := tt.filter
+
+		logs, err := resolver.Query().HTTPRequestLogs(ctx, nil, &filter)
+		if err != nil {
+			t.Fatalf("unexpected error for filter %q: %v", tt.filter, err)
+		}
+
+		var urls []string
+		for _, log := range logs {
+			urls = append(urls, log.URL)
+		}
+
+		if len(urls) != len(tt.expURLs) || !sameElements(urls, tt.expURLs) {
+			t.Errorf("filter %q: expected request logs %v, got: %v", tt.filter, tt.expURLs, urls)
+		}
+	}
+
+	_, err = resolver.Mutation().StarHTTPRequestLog(ctx, 9999, true)
+
+	var gqlErr *gqlerror.Error
+	if !errors.As(err, &gqlErr) || gqlErr.Extensions["code"] != ErrCodeNotFound {
+		t.Errorf("expected error with code %v, got: %v", ErrCodeNotFound, err)
+	}
+}
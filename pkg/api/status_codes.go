@@ -0,0 +1,25 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+)
+
+func (r *queryResolver) DistinctStatusCodes(ctx context.Context, input *HTTPRequestLogFilterInput) ([]int, error) {
+	filter, err := findRequestsFilterFromInput(input)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse request log filter: %w", err)
+	}
+
+	statusCodes, err := r.RequestLogService.DistinctStatusCodes(ctx, filter)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get distinct status codes: %w", err)
+	}
+
+	return statusCodes, nil
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+)
+
+func (r *httpRequestLogResolver) Tags(ctx context.Context, obj *HTTPRequestLog) ([]Tag, error) {
+	tags, err := r.RequestLogService.RequestTags(ctx, obj.ID)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get request log tags: %w", err)
+	}
+
+	result := make([]Tag, len(tags))
+	for i, tag := range tags {
+		result[i] = Tag{Name: tag.Name}
+		if tag.Color != "" {
+			color := tag.Color
+			result[i].Color = &color
+		}
+	}
+
+	return result, nil
+}
+
+func (r *mutationResolver) TagHTTPRequestLogs(
+	ctx context.Context,
+	input *HTTPRequestLogFilterInput,
+	name string,
+	color *string,
+) (int, error) {
+	filter, err := findRequestsFilterFromInput(input)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse request log filter: %w", err)
+	}
+
+	n, err := r.RequestLogService.TagRequests(ctx, filter, name, color)
+	if errors.Is(err, proj.ErrNoProject) {
+		return 0, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return 0, fmt.Errorf("could not tag request logs: %w", err)
+	}
+
+	return int(n), nil
+}
+
+func (r *mutationResolver) UntagHTTPRequestLogs(
+	ctx context.Context,
+	input *HTTPRequestLogFilterInput,
+	name string,
+) (int, error) {
+	filter, err := findRequestsFilterFromInput(input)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse request log filter: %w", err)
+	}
+
+	n, err := r.RequestLogService.UntagRequests(ctx, filter, name)
+	if errors.Is(err, proj.ErrNoProject) {
+		return 0, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return 0, fmt.Errorf("could not untag request logs: %w", err)
+	}
+
+	return int(n), nil
+}
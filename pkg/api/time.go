@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// defaultTimeLayout is used for `HttpRequestLog.formattedTime` when no layout
+// is given.
+const defaultTimeLayout = "2006-01-02 15:04:05 MST"
+
+// FormattedTime formats the request log timestamp using a Go time layout
+// (e.g. "2006-01-02 15:04:05"). Because timestamps are stored in UTC, the
+// result doesn't depend on the timezone of the server.
+func (r *httpRequestLogResolver) FormattedTime(
+	ctx context.Context,
+	obj *HTTPRequestLog,
+	layout *string,
+) (string, error) {
+	if layout == nil || *layout == "" {
+		return formatTime(obj.Timestamp, defaultTimeLayout), nil
+	}
+
+	return formatTime(obj.Timestamp, *layout), nil
+}
+
+func formatTime(t time.Time, layout string) string {
+	return t.UTC().Format(layout)
+}
+
+// relativeTime returns a short, human readable representation of the duration
+// between t and now, e.g. "3m ago" or "in 2h".
+func relativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	var s string
+
+	switch {
+	case d < time.Second:
+		return "just now"
+	case d < time.Minute:
+		s = fmt.Sprintf("%ds", d/time.Second)
+	case d < time.Hour:
+		s = fmt.Sprintf("%dm", d/time.Minute)
+	case d < 24*time.Hour:
+		s = fmt.Sprintf("%dh", d/time.Hour)
+	default:
+		s = fmt.Sprintf("%dd", d/(24*time.Hour))
+	}
+
+	if future {
+		return "in " + s
+	}
+
+	return s + " ago"
+}
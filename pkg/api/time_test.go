@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestFormattedTime(t *testing.T) {
+	t.Parallel()
+
+	// Same instant, in a non-UTC location.
+	ts := time.Date(2021, 1, 2, 8, 4, 5, 0, time.FixedZone("UTC+5", 5*60*60))
+	obj := &HTTPRequestLog{Timestamp: ts}
+	r := &httpRequestLogResolver{}
+
+	customLayout := time.RFC3339
+
+	tests := []struct {
+		name   string
+		layout *string
+		exp    string
+	}{
+		{name: "default layout", layout: nil, exp: "2021-01-02 03:04:05 UTC"},
+		{name: "custom layout", layout: &customLayout, exp: "2021-01-02T03:04:05Z"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := r.FormattedTime(context.Background(), obj, tt.layout)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.exp {
+				t.Errorf("expected %q, got: %q", tt.exp, got)
+			}
+		})
+	}
+}
+
+func TestRelativeTime(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		t   time.Time
+		exp string
+	}{
+		{t: now.Add(-500 * time.Millisecond), exp: "just now"},
+		{t: now.Add(-42 * time.Second), exp: "42s ago"},
+		{t: now.Add(-3*time.Minute - 10*time.Second), exp: "3m ago"},
+		{t: now.Add(-5 * time.Hour), exp: "5h ago"},
+		{t: now.Add(-49 * time.Hour), exp: "2d ago"},
+		{t: now.Add(2 * time.Hour), exp: "in 2h"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.exp, func(t *testing.T) {
+			t.Parallel()
+
+			if got := relativeTime(tt.t, now); got != tt.exp {
+				t.Errorf("expected %q, got: %q", tt.exp, got)
+			}
+		})
+	}
+
+	t.Run("computed against current time", func(t *testing.T) {
+		t.Parallel()
+
+		log := parseRequestLog(reqlog.Request{Timestamp: time.Now().Add(-3 * time.Minute)})
+
+		// Allow for the test running slowly, across a minute boundary.
+		if log.RelativeTime != "3m ago" && log.RelativeTime != "4m ago" {
+			t.Errorf("expected relative time within tolerance of \"3m ago\", got: %q", log.RelativeTime)
+		}
+	})
+}
@@ -0,0 +1,109 @@
+// Package db provides a registry of database backends, so the backend can be
+// selected at runtime by driver name.
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+// Repository is implemented by database backends. It combines the
+// repositories of all services that persist data.
+type Repository interface {
+	proj.Repository
+	reqlog.Repository
+	scope.Repository
+
+	// Ping verifies the database is reachable. It returns proj.ErrNoProject
+	// if no project database is open.
+	Ping(ctx context.Context) error
+}
+
+// Options are storage settings that are passed to a driver when a database is
+// opened. Drivers ignore settings they don't support.
+type Options struct {
+	// SkipBodyContentTypes is a list of content type prefixes (e.g. "image/")
+	// of responses whose bodies aren't stored. By default, all bodies are
+	// stored.
+	SkipBodyContentTypes []string
+	// NormalizeURLs makes request logs store a canonical URL, with sorted query
+	// parameters and without the parameters in StripQueryParams, which is used
+	// for detecting duplicate requests.
+	NormalizeURLs bool
+	// StripQueryParams is a list of query parameter keys (e.g. "utm_*") that
+	// are removed from canonical URLs.
+	StripQueryParams []string
+	// MaxDBSize is the maximum size (in bytes) of a project database. When
+	// it's exceeded, the oldest request logs are deleted. Zero means unlimited.
+	MaxDBSize int64
+	// SizeCheckInterval is how often the database size is checked against
+	// MaxDBSize. Zero means the driver's default.
+	SizeCheckInterval time.Duration
+}
+
+// OpenFunc returns a repository for a data source name, which format is
+// specific to the driver.
+type OpenFunc func(dsn string, opts Options) (Repository, error)
+
+var (
+	driversMu sync.RWMutex
+	drivers   = make(map[string]OpenFunc)
+)
+
+// Register makes a database backend available by name. Backends typically
+// register themselves in an `init` function. It panics if Register is called
+// twice with the same name, or if open is nil.
+func Register(name string, open OpenFunc) {
+	driversMu.Lock()
+	defer driversMu.Unlock()
+
+	if open == nil {
+		panic("db: open func is nil")
+	}
+
+	if _, dup := drivers[name]; dup {
+		panic("db: Register called twice for driver " + name)
+	}
+
+	drivers[name] = open
+}
+
+// Drivers returns a sorted list of the names of registered drivers.
+func Drivers() []string {
+	driversMu.RLock()
+	defer driversMu.RUnlock()
+
+	names := make([]string, 0, len(drivers))
+	for name := range drivers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Open returns a repository using a registered driver.
+func Open(driver, dsn string, opts Options) (Repository, error) {
+	driversMu.RLock()
+	open, ok := drivers[driver]
+	driversMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("db: unknown driver %q (forgotten import?)", driver)
+	}
+
+	repo, err := open(dsn, opts)
+	if err != nil {
+		return nil, fmt.Errorf("db: could not open %v database: %w", driver, err)
+	}
+
+	return repo, nil
+}
@@ -0,0 +1,79 @@
+package db_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/db"
+	_ "github.com/dstotijn/hetty/pkg/db/sqlite"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+
+	drivers := db.Drivers()
+	if len(drivers) == 0 {
+		t.Fatal("expected registered drivers")
+	}
+
+	for _, driver := range drivers {
+		driver := driver
+		t.Run(driver, func(t *testing.T) {
+			t.Parallel()
+
+			repo, err := db.Open(driver, t.TempDir(), db.Options{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer repo.Close()
+
+			if err := repo.OpenProject("test"); err != nil {
+				t.Fatalf("could not open project: %v", err)
+			}
+
+			if _, err := repo.FindRequestLogs(context.Background(), reqlog.FindRequestsFilter{}, nil); err != nil {
+				t.Fatalf("could not find request logs: %v", err)
+			}
+		})
+	}
+
+	t.Run("unknown driver", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := db.Open("foobar", "", db.Options{}); err == nil {
+			t.Fatal("expected error for unknown driver")
+		}
+	})
+}
+
+func TestOpenOptions(t *testing.T) {
+	t.Parallel()
+
+	repo, err := db.Open("sqlite", t.TempDir(), db.Options{
+		NormalizeURLs:    true,
+		StripQueryParams: []string{"utm_*"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer repo.Close()
+
+	if err := repo.OpenProject("test"); err != nil {
+		t.Fatalf("could not open project: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/?b=2&utm_source=foo&a=1", nil)
+
+	reqLog, err := repo.AddRequestLog(context.Background(), reqlog.Request{Request: *req, Timestamp: time.Now()})
+	if err != nil {
+		t.Fatalf("could not add request log: %v", err)
+	}
+
+	if exp := "https://example.com/?a=1&b=2"; reqLog.CanonicalURL != exp {
+		t.Errorf("expected canonical URL %q, got: %q", exp, reqLog.CanonicalURL)
+	}
+}
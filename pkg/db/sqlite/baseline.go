@@ -0,0 +1,36 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// SetRequestLogBaseline marks (or unmarks) a request log as a baseline, for
+// comparing other request logs against.
+func (c *Client) SetRequestLogBaseline(ctx context.Context, reqID int64, baseline bool) error {
+	if c.db == nil {
+		return proj.ErrNoProject
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	result, err := c.execLogsUpdate(ctx, `UPDATE http_requests SET is_baseline = ? WHERE id = ?`, baseline, reqID)
+	if err != nil {
+		return fmt.Errorf("sqlite: could not update baseline: %w", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("sqlite: could not get affected rows: %w", err)
+	}
+
+	if n == 0 {
+		return reqlog.ErrRequestNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package sqlite
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// bodyHash returns the hex encoded SHA-256 hash of a body, or an empty string
+// for empty bodies, which aren't hashed. It's registered as an SQL function as
+// well, so existing rows can be hashed identically.
+func bodyHash(body interface{}) string {
+	var b []byte
+
+	switch v := body.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	}
+
+	if len(b) == 0 {
+		return ""
+	}
+
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// FindRequestLogsByBodyHash returns the request logs with a request body or
+// response body with the hash, ordered by ID (descending).
+func (c *Client) FindRequestLogsByBodyHash(ctx context.Context, hash string) (reqLogs []reqlog.Request, err error) {
+	if c.db == nil {
+		return nil, proj.ErrNoProject
+	}
+
+	httpReqLogsQuery := parseHTTPRequestLogsQuery(ctx)
+	reqQuery := sq.
+		Select(httpReqLogsQuery.requestCols...).
+		From("http_requests req").
+		Where(`(req.body_hash = ? OR EXISTS (
+			SELECT 1 FROM http_responses r WHERE r.req_id = req.id AND r.body_hash = ?
+		))`, hash, hash).
+		OrderBy("req.id DESC")
+
+	if httpReqLogsQuery.joinResponse {
+		reqQuery = reqQuery.LeftJoin("http_responses res ON req.id = res.req_id")
+	}
+
+	sql, args, err := reqQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not parse query: %w", err)
+	}
+
+	rows, err := c.conn.QueryxContext(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not execute query: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var dto httpRequest
+
+		if err := rows.StructScan(&dto); err != nil {
+			return nil, fmt.Errorf("sqlite: could not scan row: %w", err)
+		}
+
+		reqLogs = append(reqLogs, dto.toRequestLog())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sqlite: could not iterate over rows: %w", err)
+	}
+
+	if err := c.queryHeaders(ctx, httpReqLogsQuery, reqLogs); err != nil {
+		return nil, fmt.Errorf("sqlite: could not query headers: %w", err)
+	}
+
+	return reqLogs, nil
+}
+
+// FindTopBodyHashes returns the hashes of the most common request and response
+// bodies, ordered by body count (descending) and hash.
+func (c *Client) FindTopBodyHashes(ctx context.Context, limit int) ([]reqlog.BodyHashCount, error) {
+	if c.db == nil {
+		return nil, proj.ErrNoProject
+	}
+
+	var dtos []struct {
+		Hash         string `db:"hash"`
+		Count        int64  `db:"count"`
+		RequestCount int64  `db:"request_count"`
+	}
+
+	err := c.conn.SelectContext(ctx, &dtos, `SELECT
+			hash,
+			COUNT(*) AS count,
+			COUNT(DISTINCT req_id) AS request_count
+		FROM (
+			SELECT body_hash AS hash, id AS req_id FROM http_requests WHERE body_hash IS NOT NULL
+			UNION ALL
+			SELECT body_hash AS hash, req_id FROM http_responses WHERE body_hash IS NOT NULL
+		)
+		GROUP BY hash
+		ORDER BY count DESC, hash
+		LIMIT ?`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not query top body hashes: %w", err)
+	}
+
+	hashCounts := make([]reqlog.BodyHashCount, len(dtos))
+	for i, dto := range dtos {
+		hashCounts[i] = reqlog.BodyHashCount{
+			Hash:         dto.Hash,
+			Count:        dto.Count,
+			RequestCount: dto.RequestCount,
+		}
+	}
+
+	return hashCounts, nil
+}
@@ -0,0 +1,128 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// BulkImportRequestLogs stores request logs and their response logs in a
+// single transaction, with foreign key checks disabled, which makes large
+// imports faster. Before the transaction is committed, it's checked that the
+// import didn't leave orphaned response logs or headers; if it did, nothing is
+// stored. Entries with a key that was imported before are skipped, like with
+// ImportRequestLog. It returns the number of inserted request logs.
+//
+// Foreign key checks are only disabled on a dedicated connection, for the
+// duration of the import. Other connections are unaffected.
+func (c *Client) BulkImportRequestLogs(ctx context.Context, entries []reqlog.ImportEntry) (int, error) {
+	if c.db == nil {
+		return 0, proj.ErrNoProject
+	}
+
+	// The foreign keys pragma is a no-op within a transaction.
+	if c.inTx {
+		return 0, errors.New("sqlite: bulk import can't be used in a transaction")
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	conn, err := c.db.Conn(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `PRAGMA foreign_keys = OFF`); err != nil {
+		return 0, fmt.Errorf("sqlite: could not disable foreign keys: %w", err)
+	}
+
+	// The connection is returned to the pool when it's closed, so foreign keys
+	// must be enabled again. If that fails, the connection is discarded.
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), `PRAGMA foreign_keys = ON`); err != nil {
+			log.Printf("[ERROR] Could not enable foreign keys after bulk import, discarding connection: %v", err)
+
+			_ = conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+		}
+	}()
+
+	sqlTx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not start transaction: %w", err)
+	}
+
+	tx := &sqlx.Tx{Tx: sqlTx, Mapper: c.db.Mapper}
+	defer tx.Rollback()
+
+	// Orphans that existed before the import (e.g. written by other tools)
+	// aren't the import's fault, so only response logs and headers that are
+	// inserted by the import are checked. They have higher IDs than existing
+	// ones.
+	var maxIDs struct {
+		Response int64 `db:"max_res_id"`
+		Header   int64 `db:"max_header_id"`
+	}
+
+	err = tx.GetContext(ctx, &maxIDs, `SELECT
+		(SELECT IFNULL(MAX(id), 0) FROM http_responses) AS max_res_id,
+		(SELECT IFNULL(MAX(id), 0) FROM http_headers) AS max_header_id`)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not query max IDs: %w", err)
+	}
+
+	var inserted int
+
+	for _, entry := range entries {
+		reqLog := entry.Request
+		reqLog.Request.URL = absoluteURL(reqLog.Request)
+		reqLog.Timestamp = reqLog.Timestamp.UTC()
+		reqLog.Response = nil
+
+		err := c.insertRequestLog(ctx, tx, &reqLog, entry.Key)
+		if errors.Is(err, errIdempotencyConflict) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		if resLog := entry.Request.Response; resLog != nil {
+			err := c.insertResponseLog(ctx, tx, &reqlog.Response{
+				RequestID: reqLog.ID,
+				Response:  resLog.Response,
+				Body:      resLog.Body,
+				Timestamp: resLog.Timestamp.UTC(),
+			})
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		inserted++
+	}
+
+	report, err := checkIntegrityAfter(ctx, tx, maxIDs.Response, maxIDs.Header)
+	if err != nil {
+		return 0, err
+	}
+
+	if report.OrphanResponses > 0 || report.OrphanHeaders > 0 {
+		return 0, fmt.Errorf("sqlite: bulk import would leave %v orphan responses and %v orphan headers",
+			report.OrphanResponses, report.OrphanHeaders)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("sqlite: could not commit transaction: %w", err)
+	}
+
+	return inserted, nil
+}
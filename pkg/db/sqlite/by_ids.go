@@ -0,0 +1,229 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// maxIDsPerQuery is the maximum number of IDs bound to a single `IN` clause,
+// well below the maximum number of SQLite host parameters.
+const maxIDsPerQuery = 500
+
+var errInvalidHeader = errors.New("sqlite: header has no key or value")
+
+// FindRequestLogsByIDs returns the request logs with the IDs, in the order of
+// ids. Unknown IDs are skipped. Headers are queried per batch of request logs,
+// rather than per request log.
+func (c *Client) FindRequestLogsByIDs(ctx context.Context, ids []int64) ([]reqlog.Request, error) {
+	if c.db == nil {
+		return nil, proj.ErrNoProject
+	}
+
+	httpReqLogsQuery := parseHTTPRequestLogsQuery(ctx)
+	reqLogsByID := make(map[int64]reqlog.Request, len(ids))
+
+	for start := 0; start < len(ids); start += maxIDsPerQuery {
+		end := start + maxIDsPerQuery
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		reqLogs, err := c.findRequestLogsByIDs(ctx, httpReqLogsQuery, ids[start:end])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, reqLog := range reqLogs {
+			reqLogsByID[reqLog.ID] = reqLog
+		}
+	}
+
+	reqLogs := make([]reqlog.Request, 0, len(reqLogsByID))
+
+	for _, id := range ids {
+		if reqLog, ok := reqLogsByID[id]; ok {
+			reqLogs = append(reqLogs, reqLog)
+		}
+	}
+
+	return reqLogs, nil
+}
+
+func (c *Client) findRequestLogsByIDs(
+	ctx context.Context,
+	httpReqLogsQuery httpRequestLogsQuery,
+	ids []int64,
+) ([]reqlog.Request, error) {
+	reqQuery := sq.
+		Select(httpReqLogsQuery.requestCols...).
+		From("http_requests req").
+		Where(sq.Eq{"req.id": ids})
+
+	if httpReqLogsQuery.joinResponse {
+		reqQuery = reqQuery.LeftJoin("http_responses res ON req.id = res.req_id")
+	}
+
+	reqSQL, args, err := reqQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not parse query: %w", err)
+	}
+
+	rows, err := c.conn.QueryxContext(ctx, reqSQL, args...)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var reqLogs []reqlog.Request
+
+	for rows.Next() {
+		var dto httpRequest
+
+		if err := rows.StructScan(&dto); err != nil {
+			return nil, fmt.Errorf("sqlite: could not scan row: %w", err)
+		}
+
+		reqLogs = append(reqLogs, dto.toRequestLog())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sqlite: could not iterate over rows: %w", err)
+	}
+
+	if err := c.queryHeadersBatch(ctx, httpReqLogsQuery, reqLogs); err != nil {
+		return nil, fmt.Errorf("sqlite: could not query headers: %w", err)
+	}
+
+	return reqLogs, nil
+}
+
+// queryHeadersBatch sets the headers of request logs like queryHeaders, but
+// with a single query for all request headers, and one for all response
+// headers. Like queryHeaders, a request log or response log with headers that
+// can't be read gets an error, rather than failing all request logs.
+func (c *Client) queryHeadersBatch(
+	ctx context.Context,
+	query httpRequestLogsQuery,
+	reqLogs []reqlog.Request,
+) error {
+	if len(query.requestHeaderCols) > 0 {
+		reqIDs := make([]int64, len(reqLogs))
+		reqLogsByID := make(map[int64]*reqlog.Request, len(reqLogs))
+
+		for i := range reqLogs {
+			reqIDs[i] = reqLogs[i].ID
+			reqLogsByID[reqLogs[i].ID] = &reqLogs[i]
+			reqLogs[i].Request.Header = make(http.Header)
+		}
+
+		err := c.findHeadersBatch(ctx, "req_id", reqIDs, func(id int64, key, value string, err error) {
+			reqLog := reqLogsByID[id]
+
+			switch {
+			case err != nil && reqLog.HeadersErr == nil:
+				reqLog.Request.Header = make(http.Header)
+				reqLog.HeadersErr = fmt.Errorf("could not query request headers: %w", err)
+			case err == nil && reqLog.HeadersErr == nil:
+				reqLog.Request.Header.Add(key, value)
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("could not query request headers: %w", err)
+		}
+	}
+
+	if len(query.responseHeaderCols) > 0 {
+		var resIDs []int64
+
+		resLogsByID := make(map[int64]*reqlog.Response, len(reqLogs))
+
+		for i := range reqLogs {
+			if reqLogs[i].Response == nil {
+				continue
+			}
+
+			resIDs = append(resIDs, reqLogs[i].Response.ID)
+			resLogsByID[reqLogs[i].Response.ID] = reqLogs[i].Response
+			reqLogs[i].Response.Response.Header = make(http.Header)
+		}
+
+		err := c.findHeadersBatch(ctx, "res_id", resIDs, func(id int64, key, value string, err error) {
+			resLog := resLogsByID[id]
+
+			switch {
+			case err != nil && resLog.HeadersErr == nil:
+				resLog.Response.Header = make(http.Header)
+				resLog.HeadersErr = fmt.Errorf("could not query response headers: %w", err)
+			case err == nil && resLog.HeadersErr == nil:
+				resLog.Response.Header.Add(key, value)
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("could not query response headers: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// findHeadersBatch calls fn for every header of the request logs or response
+// logs with the IDs, in insertion order. The header owner column is either
+// `req_id` or `res_id`. Headers with a NULL key or value are passed to fn with
+// an error, so the owner can be flagged.
+func (c *Client) findHeadersBatch(
+	ctx context.Context,
+	ownerCol string,
+	ids []int64,
+	fn func(id int64, key, value string, err error),
+) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	headersSQL, args, err := sq.
+		Select(ownerCol, "key", "value").
+		From("http_headers").
+		Where(sq.Eq{ownerCol: ids}).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("sqlite: could not parse query: %w", err)
+	}
+
+	rows, err := c.conn.QueryContext(ctx, headersSQL, args...)
+	if err != nil {
+		return fmt.Errorf("sqlite: could not execute query: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id         int64
+			key, value *string
+		)
+
+		if err := rows.Scan(&id, &key, &value); err != nil {
+			return fmt.Errorf("sqlite: could not scan row: %w", err)
+		}
+
+		if key == nil || value == nil {
+			fn(id, "", "", errInvalidHeader)
+			continue
+		}
+
+		fn(id, *key, *value, nil)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("sqlite: could not iterate over rows: %w", err)
+	}
+
+	return nil
+}
@@ -1,41 +1,79 @@
 package sqlite
 
 import (
+	"crypto/tls"
 	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
-	"time"
 
 	"github.com/dstotijn/hetty/pkg/reqlog"
 )
 
 type reqURL url.URL
 
+// httpRequest is scanned from a variable set of columns, depending on the
+// requested fields. Apart from the ID, fields must therefore tolerate both
+// absent and `NULL` values.
 type httpRequest struct {
-	ID        int64     `db:"req_id"`
-	Proto     string    `db:"req_proto"`
-	URL       reqURL    `db:"url"`
-	Method    string    `db:"method"`
-	Body      []byte    `db:"req_body"`
-	Timestamp time.Time `db:"req_timestamp"`
+	ID               int64          `db:"req_id"`
+	Proto            sql.NullString `db:"req_proto"`
+	URL              reqURL         `db:"url"`
+	Method           sql.NullString `db:"method"`
+	Body             []byte         `db:"req_body"`
+	Timestamp        sql.NullTime   `db:"req_timestamp"`
+	TLSVersion       sql.NullInt64  `db:"tls_version"`
+	TLSCipher        sql.NullInt64  `db:"tls_cipher"`
+	Fingerprint      sql.NullString `db:"fingerprint"`
+	HostHeader       sql.NullString `db:"host_header"`
+	Referer          sql.NullString `db:"referer"`
+	HeadersTruncated sql.NullBool   `db:"req_headers_truncated"`
+	ServerAddr       sql.NullString `db:"server_addr"`
+	BodyTruncated    sql.NullBool   `db:"req_body_truncated"`
+	BodySize         sql.NullInt64  `db:"req_body_size"`
+	BodyLineCount    sql.NullInt64  `db:"req_body_line_count"`
+	CanonicalURL     sql.NullString `db:"canonical_url"`
+	IsBaseline       sql.NullBool   `db:"is_baseline"`
+	ConnID           sql.NullInt64  `db:"conn_id"`
+	GRPCMethod       sql.NullString `db:"grpc_method"`
+	SNI              sql.NullString `db:"sni"`
+	BodyHash         sql.NullString `db:"req_body_hash"`
+	Listener         sql.NullString `db:"listener"`
+	RedirectedFrom   sql.NullInt64  `db:"redirected_from"`
+	ClientCert       sql.NullBool   `db:"client_cert"`
+	Starred          sql.NullBool   `db:"starred"`
+	Unread           sql.NullBool   `db:"unread"`
 	httpResponse
 }
 
 type httpResponse struct {
-	ID           sql.NullInt64  `db:"res_id"`
-	RequestID    sql.NullInt64  `db:"res_req_id"`
-	Proto        sql.NullString `db:"res_proto"`
-	StatusCode   sql.NullInt64  `db:"status_code"`
-	StatusReason sql.NullString `db:"status_reason"`
-	Body         []byte         `db:"res_body"`
-	Timestamp    sql.NullTime   `db:"res_timestamp"`
+	ID                    sql.NullInt64  `db:"res_id"`
+	RequestID             sql.NullInt64  `db:"res_req_id"`
+	Proto                 sql.NullString `db:"res_proto"`
+	StatusCode            sql.NullInt64  `db:"status_code"`
+	StatusReason          sql.NullString `db:"status_reason"`
+	Body                  []byte         `db:"res_body"`
+	Timestamp             sql.NullTime   `db:"res_timestamp"`
+	ContentLengthMismatch sql.NullBool   `db:"content_length_mismatch"`
+	BodySkipped           sql.NullBool   `db:"body_skipped"`
+	HeadersTruncated      sql.NullBool   `db:"res_headers_truncated"`
+	BodyTruncated         sql.NullBool   `db:"res_body_truncated"`
+	BodySize              sql.NullInt64  `db:"res_body_size"`
+	BodyLineCount         sql.NullInt64  `db:"res_body_line_count"`
+	Timings               resTimings     `db:"timings"`
+	CacheStatus           sql.NullString `db:"cache_status"`
+	BodyHash              sql.NullString `db:"res_body_hash"`
 }
 
 // Value implements driver.Valuer.
 func (u *reqURL) Scan(value interface{}) error {
+	if value == nil {
+		*u = reqURL{}
+		return nil
+	}
+
 	rawURL, ok := value.(string)
 	if !ok {
 		return errors.New("sqlite: cannot scan non-string value")
@@ -56,12 +94,42 @@ func (dto httpRequest) toRequestLog() reqlog.Request {
 	reqLog := reqlog.Request{
 		ID: dto.ID,
 		Request: http.Request{
-			Proto:  dto.Proto,
-			Method: dto.Method,
+			Proto:  dto.Proto.String,
+			Method: dto.Method.String,
 			URL:    &u,
+			Host:   dto.HostHeader.String,
 		},
-		Body:      dto.Body,
-		Timestamp: dto.Timestamp,
+		Body:             dto.Body,
+		Timestamp:        dto.Timestamp.Time.UTC(),
+		Fingerprint:      dto.Fingerprint.String,
+		Referer:          dto.Referer.String,
+		HeadersTruncated: dto.HeadersTruncated.Bool,
+		ServerAddr:       dto.ServerAddr.String,
+		BodyTruncated:    dto.BodyTruncated.Bool,
+		BodySize:         dto.BodySize.Int64,
+		CanonicalURL:     dto.CanonicalURL.String,
+		IsBaseline:       dto.IsBaseline.Bool,
+		ConnID:           dto.ConnID.Int64,
+		GRPCMethod:       dto.GRPCMethod.String,
+		SNI:              dto.SNI.String,
+		BodyHash:         dto.BodyHash.String,
+		Listener:         dto.Listener.String,
+		RedirectedFrom:   dto.RedirectedFrom.Int64,
+		ClientCert:       dto.ClientCert.Bool,
+		Starred:          dto.Starred.Bool,
+		Unread:           dto.Unread.Bool,
+	}
+
+	if dto.BodyLineCount.Valid {
+		n := dto.BodyLineCount.Int64
+		reqLog.BodyLineCount = &n
+	}
+
+	if dto.TLSVersion.Valid {
+		reqLog.Request.TLS = &tls.ConnectionState{
+			Version:     uint16(dto.TLSVersion.Int64),
+			CipherSuite: uint16(dto.TLSCipher.Int64),
+		}
 	}
 
 	if dto.httpResponse.ID.Valid {
@@ -69,14 +137,40 @@ func (dto httpRequest) toRequestLog() reqlog.Request {
 			ID:        dto.httpResponse.ID.Int64,
 			RequestID: dto.httpResponse.RequestID.Int64,
 			Response: http.Response{
-				Status:     strconv.FormatInt(dto.StatusCode.Int64, 10) + " " + dto.StatusReason.String,
+				Status:     status(dto.StatusCode.Int64, dto.StatusReason.String),
 				StatusCode: int(dto.StatusCode.Int64),
 				Proto:      dto.httpResponse.Proto.String,
 			},
-			Body:      dto.httpResponse.Body,
-			Timestamp: dto.httpResponse.Timestamp.Time,
+			Body:                  dto.httpResponse.Body,
+			Timestamp:             dto.httpResponse.Timestamp.Time.UTC(),
+			ContentLengthMismatch: dto.ContentLengthMismatch.Bool,
+			BodySkipped:           dto.BodySkipped.Bool,
+			HeadersTruncated:      dto.httpResponse.HeadersTruncated.Bool,
+			BodyTruncated:         dto.httpResponse.BodyTruncated.Bool,
+			BodySize:              dto.httpResponse.BodySize.Int64,
+			Timings:               dto.Timings.toTimings(),
+			CacheStatus:           reqlog.CacheStatusUnknown,
+			BodyHash:              dto.httpResponse.BodyHash.String,
+		}
+
+		if dto.CacheStatus.Valid {
+			reqLog.Response.CacheStatus = reqlog.CacheStatus(dto.CacheStatus.String)
+		}
+
+		if dto.httpResponse.BodyLineCount.Valid {
+			n := dto.httpResponse.BodyLineCount.Int64
+			reqLog.Response.BodyLineCount = &n
 		}
 	}
 
 	return reqLog
 }
+
+// status returns a status line, without a trailing space if there's no reason.
+func status(code int64, reason string) string {
+	if reason == "" {
+		return strconv.FormatInt(code, 10)
+	}
+
+	return strconv.FormatInt(code, 10) + " " + reason
+}
@@ -0,0 +1,104 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func (c *Client) FindDuplicateRequestLogs(ctx context.Context) ([]reqlog.DuplicateRequests, error) {
+	if c.db == nil {
+		return nil, proj.ErrNoProject
+	}
+
+	rows, err := c.conn.QueryxContext(ctx, `SELECT fingerprint, id FROM http_requests
+		WHERE fingerprint IN (
+			SELECT fingerprint FROM http_requests
+			WHERE fingerprint IS NOT NULL
+			GROUP BY fingerprint
+			HAVING COUNT(*) > 1
+		)
+		ORDER BY fingerprint, id`)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not query duplicate requests: %w", err)
+	}
+	defer rows.Close()
+
+	var clusters []reqlog.DuplicateRequests
+
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("sqlite: query aborted: %w", err)
+		}
+
+		var (
+			fp string
+			id int64
+		)
+
+		if err := rows.Scan(&fp, &id); err != nil {
+			return nil, fmt.Errorf("sqlite: could not scan row: %w", err)
+		}
+
+		if len(clusters) == 0 || clusters[len(clusters)-1].Fingerprint != fp {
+			clusters = append(clusters, reqlog.DuplicateRequests{Fingerprint: fp})
+		}
+
+		cluster := &clusters[len(clusters)-1]
+		cluster.RequestIDs = append(cluster.RequestIDs, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sqlite: could not iterate over rows: %w", err)
+	}
+
+	return clusters, nil
+}
+
+// CountDuplicateRequestLogs returns the number of request logs that would be
+// removed by `DeleteDuplicateRequestLogs`.
+func (c *Client) CountDuplicateRequestLogs(ctx context.Context) (int64, error) {
+	if c.db == nil {
+		return 0, proj.ErrNoProject
+	}
+
+	var count int64
+
+	err := c.conn.GetContext(ctx, &count, `SELECT COUNT(*) - COUNT(DISTINCT fingerprint) FROM http_requests
+		WHERE fingerprint IS NOT NULL`)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not count duplicate requests: %w", err)
+	}
+
+	return count, nil
+}
+
+// DeleteDuplicateRequestLogs deletes duplicate request logs, keeping the oldest
+// request log of each set of duplicates.
+func (c *Client) DeleteDuplicateRequestLogs(ctx context.Context) (int64, error) {
+	if c.db == nil {
+		return 0, proj.ErrNoProject
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	result, err := c.conn.ExecContext(ctx, `DELETE FROM http_requests
+		WHERE fingerprint IS NOT NULL AND id NOT IN (
+			SELECT MIN(id) FROM http_requests
+			WHERE fingerprint IS NOT NULL
+			GROUP BY fingerprint
+		)`)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not delete duplicate requests: %w", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not get affected rows: %w", err)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,163 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+const (
+	defaultSizeCheckInterval = time.Minute
+	evictionBatchSize        = 10
+)
+
+// startEviction periodically enforces the maximum database size, until
+// stopEviction is called.
+func (c *Client) startEviction() {
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	c.stopEvictionCh = stop
+	c.evictionDone = done
+
+	interval := c.config.SizeCheckInterval
+	if interval <= 0 {
+		interval = defaultSizeCheckInterval
+	}
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+
+			n, err := c.enforceMaxDBSize(context.Background())
+			if err != nil {
+				log.Printf("[ERROR] Could not enforce maximum database size: %v", err)
+				continue
+			}
+
+			if n > 0 {
+				log.Printf("[INFO] Evicted %v oldest request logs to limit database size to %v bytes.", n, c.config.MaxDBSize)
+			}
+		}
+	}()
+}
+
+func (c *Client) stopEviction() {
+	if c.stopEvictionCh == nil {
+		return
+	}
+
+	close(c.stopEvictionCh)
+	<-c.evictionDone
+
+	c.stopEvictionCh = nil
+	c.evictionDone = nil
+}
+
+// enforceMaxDBSize deletes the oldest request logs while the database is larger
+// than the configured maximum size, and then vacuums it to shrink the file.
+// Because deleted rows only free up pages within the file, eviction stops once
+// the pages in use fit within the maximum size. It returns the number of
+// deleted request logs.
+func (c *Client) enforceMaxDBSize(ctx context.Context) (int64, error) {
+	size, used, err := c.dbSize(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if size <= c.config.MaxDBSize {
+		return 0, nil
+	}
+
+	var evicted int64
+
+	for used > c.config.MaxDBSize {
+		n, err := c.evictOldestRequestLogs(ctx, evictionBatchSize)
+		if err != nil {
+			return evicted, err
+		}
+
+		if n == 0 {
+			break
+		}
+
+		evicted += n
+
+		if _, used, err = c.dbSize(ctx); err != nil {
+			return evicted, err
+		}
+	}
+
+	if err := c.vacuum(ctx); err != nil {
+		return evicted, err
+	}
+
+	return evicted, nil
+}
+
+// dbSize returns the size of the database, and the size of its pages that are
+// in use (i.e. not on the freelist), both in bytes. Pages in the write-ahead
+// log that haven't been checkpointed yet are included.
+func (c *Client) dbSize(ctx context.Context) (size, used int64, err error) {
+	var pageSize, pageCount, freelistCount int64
+
+	if err := c.db.GetContext(ctx, &pageSize, "PRAGMA page_size"); err != nil {
+		return 0, 0, fmt.Errorf("sqlite: could not get page size: %w", err)
+	}
+
+	if err := c.db.GetContext(ctx, &pageCount, "PRAGMA page_count"); err != nil {
+		return 0, 0, fmt.Errorf("sqlite: could not get page count: %w", err)
+	}
+
+	if err := c.db.GetContext(ctx, &freelistCount, "PRAGMA freelist_count"); err != nil {
+		return 0, 0, fmt.Errorf("sqlite: could not get freelist count: %w", err)
+	}
+
+	return pageCount * pageSize, (pageCount - freelistCount) * pageSize, nil
+}
+
+func (c *Client) evictOldestRequestLogs(ctx context.Context, limit int) (int64, error) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	res, err := c.db.ExecContext(ctx, `DELETE FROM http_requests WHERE id IN (
+		SELECT id FROM http_requests ORDER BY timestamp, id LIMIT ?
+	)`, limit)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not delete oldest requests: %w", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not get rows affected: %w", err)
+	}
+
+	return n, nil
+}
+
+// vacuum rebuilds the database file without free pages, and truncates the
+// write-ahead log, so the file size on disk shrinks.
+func (c *Client) vacuum(ctx context.Context) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if _, err := c.db.ExecContext(ctx, "VACUUM"); err != nil {
+		return fmt.Errorf("sqlite: could not vacuum database: %w", err)
+	}
+
+	if _, err := c.db.ExecContext(ctx, "PRAGMA wal_checkpoint(TRUNCATE)"); err != nil {
+		return fmt.Errorf("sqlite: could not checkpoint database: %w", err)
+	}
+
+	return nil
+}
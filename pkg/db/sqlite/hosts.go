@@ -0,0 +1,55 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+// FindTopHosts returns the hosts with the most request logs matching a filter,
+// ordered by request count (descending) and host.
+func (c *Client) FindTopHosts(
+	ctx context.Context,
+	filter reqlog.FindRequestsFilter,
+	scope *scope.Scope,
+	limit int,
+) ([]reqlog.HostCount, error) {
+	if c.db == nil {
+		return nil, proj.ErrNoProject
+	}
+
+	reqQuery, err := findRequestLogsQuery(httpRequestLogsQuery{
+		requestCols: []string{"url_host(req.url) AS host"},
+	}, filter, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	reqSQL, args, err := reqQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not parse query: %w", err)
+	}
+
+	var dtos []struct {
+		Host  string `db:"host"`
+		Count int64  `db:"count"`
+	}
+
+	err = c.conn.SelectContext(ctx, &dtos, `SELECT host, COUNT(*) AS count FROM (`+reqSQL+`)
+		GROUP BY host
+		ORDER BY count DESC, host
+		LIMIT ?`, append(args, limit)...)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not query top hosts: %w", err)
+	}
+
+	hostCounts := make([]reqlog.HostCount, len(dtos))
+	for i, dto := range dtos {
+		hostCounts[i] = reqlog.HostCount{Host: dto.Host, Count: dto.Count}
+	}
+
+	return hostCounts, nil
+}
@@ -0,0 +1,83 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// ImportRequestLog stores a request log, and its response log if it has one,
+// in a single transaction. If key isn't empty, and a request log with the same
+// key was imported before, nothing is stored, and the existing request log is
+// returned instead. It reports whether the request log was newly inserted.
+func (c *Client) ImportRequestLog(
+	ctx context.Context,
+	key string,
+	reqLog reqlog.Request,
+) (*reqlog.Request, bool, error) {
+	if c.db == nil {
+		return nil, false, proj.ErrNoProject
+	}
+
+	reqLog.Request.URL = absoluteURL(reqLog.Request)
+	reqLog.Timestamp = reqLog.Timestamp.UTC()
+
+	resLog := reqLog.Response
+	reqLog.Response = nil
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	tx, err := c.beginTx(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("sqlite: could not start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = c.insertRequestLog(ctx, tx, &reqLog, key)
+	if errors.Is(err, errIdempotencyConflict) {
+		var reqID int64
+
+		if err := tx.GetContext(ctx, &reqID, `SELECT id FROM http_requests WHERE idempotency_key = ?`, key); err != nil {
+			return nil, false, fmt.Errorf("sqlite: could not query imported request log: %w", err)
+		}
+
+		// Nothing was written, so the transaction can be ended before the
+		// existing request log is read.
+		tx.Rollback()
+
+		existing, err := c.FindRequestLogByID(ctx, reqID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return &existing, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if resLog != nil {
+		resLog := &reqlog.Response{
+			RequestID: reqLog.ID,
+			Response:  resLog.Response,
+			Body:      resLog.Body,
+			Timestamp: resLog.Timestamp.UTC(),
+		}
+
+		if err := c.insertResponseLog(ctx, tx, resLog); err != nil {
+			return nil, false, err
+		}
+
+		reqLog.Response = resLog
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, false, fmt.Errorf("sqlite: could not commit transaction: %w", err)
+	}
+
+	return &reqLog, true, nil
+}
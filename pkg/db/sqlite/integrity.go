@@ -0,0 +1,123 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// Orphans can't be created while foreign keys are enforced, but can exist in
+// databases that were written without them (e.g. by other tools).
+const (
+	orphanResponsesCond = `req_id IS NULL OR req_id NOT IN (SELECT id FROM http_requests)`
+	orphanHeadersCond   = `(req_id IS NULL AND res_id IS NULL)
+		OR (req_id IS NOT NULL AND req_id NOT IN (SELECT id FROM http_requests))
+		OR (res_id IS NOT NULL AND res_id NOT IN (SELECT id FROM http_responses))`
+)
+
+// CheckIntegrity returns the number of response logs and headers that don't
+// belong to an existing request log or response log.
+func (c *Client) CheckIntegrity(ctx context.Context) (reqlog.IntegrityReport, error) {
+	if c.db == nil {
+		return reqlog.IntegrityReport{}, proj.ErrNoProject
+	}
+
+	return checkIntegrity(ctx, c.conn)
+}
+
+func checkIntegrity(ctx context.Context, conn dbConn) (reqlog.IntegrityReport, error) {
+	return checkIntegrityAfter(ctx, conn, 0, 0)
+}
+
+// checkIntegrityAfter is like checkIntegrity, but only counts response logs with
+// an ID greater than resID, and headers with an ID greater than headerID.
+func checkIntegrityAfter(ctx context.Context, conn dbConn, resID, headerID int64) (reqlog.IntegrityReport, error) {
+	var report reqlog.IntegrityReport
+
+	err := conn.GetContext(ctx, &report.OrphanResponses,
+		`SELECT COUNT(*) FROM http_responses WHERE id > ? AND (`+orphanResponsesCond+`)`, resID)
+	if err != nil {
+		return reqlog.IntegrityReport{}, fmt.Errorf("sqlite: could not count orphan responses: %w", err)
+	}
+
+	err = conn.GetContext(ctx, &report.OrphanHeaders,
+		`SELECT COUNT(*) FROM http_headers WHERE id > ? AND (`+orphanHeadersCond+`)`, headerID)
+	if err != nil {
+		return reqlog.IntegrityReport{}, fmt.Errorf("sqlite: could not count orphan headers: %w", err)
+	}
+
+	return report, nil
+}
+
+// DeleteOrphans deletes response logs and headers that don't belong to an
+// existing request log or response log. It returns the number of deleted
+// orphans. Headers of deleted response logs are deleted as well, but aren't
+// counted.
+func (c *Client) DeleteOrphans(ctx context.Context) (reqlog.IntegrityReport, error) {
+	if c.db == nil {
+		return reqlog.IntegrityReport{}, proj.ErrNoProject
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	tx, err := c.beginTx(ctx)
+	if err != nil {
+		return reqlog.IntegrityReport{}, fmt.Errorf("sqlite: could not start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var report reqlog.IntegrityReport
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM http_responses WHERE `+orphanResponsesCond)
+	if err != nil {
+		return reqlog.IntegrityReport{}, fmt.Errorf("sqlite: could not delete orphan responses: %w", err)
+	}
+
+	report.OrphanResponses, err = result.RowsAffected()
+	if err != nil {
+		return reqlog.IntegrityReport{}, fmt.Errorf("sqlite: could not get affected rows: %w", err)
+	}
+
+	result, err = tx.ExecContext(ctx, `DELETE FROM http_headers WHERE `+orphanHeadersCond)
+	if err != nil {
+		return reqlog.IntegrityReport{}, fmt.Errorf("sqlite: could not delete orphan headers: %w", err)
+	}
+
+	report.OrphanHeaders, err = result.RowsAffected()
+	if err != nil {
+		return reqlog.IntegrityReport{}, fmt.Errorf("sqlite: could not get affected rows: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return reqlog.IntegrityReport{}, fmt.Errorf("sqlite: could not commit transaction: %w", err)
+	}
+
+	return report, nil
+}
+
+// PruneOrphanHeaders deletes headers that don't belong to an existing request
+// log or response log, e.g. of response logs that were deleted while foreign
+// keys weren't enforced. It returns the number of deleted headers.
+func (c *Client) PruneOrphanHeaders(ctx context.Context) (int64, error) {
+	if c.db == nil {
+		return 0, proj.ErrNoProject
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	result, err := c.conn.ExecContext(ctx, `DELETE FROM http_headers WHERE `+orphanHeadersCond)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not delete orphan headers: %w", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not get affected rows: %w", err)
+	}
+
+	return n, nil
+}
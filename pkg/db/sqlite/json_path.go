@@ -0,0 +1,135 @@
+package sqlite
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPathMatch reports whether the value at path in a JSON document equals
+// want. Documents that aren't valid JSON never match. String values are
+// compared as is, other values by their JSON encoding (e.g. `true` or `42`).
+// It's registered as an SQL function, because the driver isn't built with the
+// JSON1 extension (and its `json_extract` function).
+func jsonPathMatch(doc interface{}, path, want string) (bool, error) {
+	segments, err := parseJSONPath(path)
+	if err != nil {
+		return false, err
+	}
+
+	var b []byte
+
+	switch v := doc.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return false, nil
+	}
+
+	got, ok := jsonPathValue(b, segments)
+
+	return ok && got == want, nil
+}
+
+// jsonPathValue returns the value at the path segments in a JSON document, and
+// false if the document isn't valid JSON or the path doesn't exist.
+func jsonPathValue(doc []byte, segments []string) (string, bool) {
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+
+	var value interface{}
+
+	if err := dec.Decode(&value); err != nil {
+		return "", false
+	}
+
+	for _, segment := range segments {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if value, ok = v[segment]; !ok {
+				return "", false
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(v) {
+				return "", false
+			}
+
+			value = v[i]
+		default:
+			return "", false
+		}
+	}
+
+	if s, ok := value.(string); ok {
+		return s, true
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", false
+	}
+
+	return string(encoded), true
+}
+
+var jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// parseJSONPath splits a path into object keys and array indices. Paths are
+// either in dot notation (e.g. `$.user.roles[0]`, where `$.` is optional), or
+// a JSON pointer (e.g. `/user/roles/0`).
+func parseJSONPath(path string) ([]string, error) {
+	if strings.HasPrefix(path, "/") {
+		segments := strings.Split(path[1:], "/")
+		for i, segment := range segments {
+			segments[i] = jsonPointerUnescaper.Replace(segment)
+		}
+
+		return segments, nil
+	}
+
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if path == "" {
+		return nil, errors.New("sqlite: JSON path cannot be empty")
+	}
+
+	var segments []string
+
+	for _, part := range strings.Split(path, ".") {
+		if part == "" {
+			return nil, fmt.Errorf("sqlite: invalid JSON path (%v)", path)
+		}
+
+		key := part
+		if i := strings.Index(part, "["); i != -1 {
+			key = part[:i]
+		}
+
+		if key != "" {
+			segments = append(segments, key)
+		}
+
+		for rest := part[len(key):]; rest != ""; {
+			end := strings.Index(rest, "]")
+			if rest[0] != '[' || end == -1 {
+				return nil, fmt.Errorf("sqlite: invalid JSON path (%v)", path)
+			}
+
+			index := rest[1:end]
+			if _, err := strconv.Atoi(index); err != nil {
+				return nil, fmt.Errorf("sqlite: invalid array index in JSON path (%v)", path)
+			}
+
+			segments = append(segments, index)
+			rest = rest[end+1:]
+		}
+	}
+
+	return segments, nil
+}
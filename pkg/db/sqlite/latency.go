@@ -0,0 +1,53 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+// FindRequestLogDurations returns the durations between request and response
+// of all request logs matching a filter. Request logs without a response are
+// skipped.
+func (c *Client) FindRequestLogDurations(
+	ctx context.Context,
+	filter reqlog.FindRequestsFilter,
+	scope *scope.Scope,
+) ([]time.Duration, error) {
+	if c.db == nil {
+		return nil, proj.ErrNoProject
+	}
+
+	reqQuery, err := findRequestLogsQuery(httpRequestLogsQuery{
+		requestCols:  []string{"req.timestamp AS req_timestamp", "res.timestamp AS res_timestamp"},
+		joinResponse: true,
+	}, filter, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	sql, args, err := reqQuery.Where("res.id IS NOT NULL").ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not parse query: %w", err)
+	}
+
+	var dtos []struct {
+		ReqTimestamp time.Time `db:"req_timestamp"`
+		ResTimestamp time.Time `db:"res_timestamp"`
+	}
+
+	if err := c.conn.SelectContext(ctx, &dtos, sql, args...); err != nil {
+		return nil, fmt.Errorf("sqlite: could not query durations: %w", err)
+	}
+
+	durations := make([]time.Duration, len(dtos))
+	for i, dto := range dtos {
+		durations[i] = dto.ResTimestamp.Sub(dto.ReqTimestamp)
+	}
+
+	return durations, nil
+}
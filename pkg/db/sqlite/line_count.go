@@ -0,0 +1,41 @@
+package sqlite
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+// Line counts are computed by the database, like body sizes, so they can be
+// queried without transferring the bodies themselves. Binary bodies have a
+// line count of NULL.
+const (
+	reqBodyLineCountCol = "NULLIF(body_line_count(req.body), -1) AS req_body_line_count"
+	resBodyLineCountCol = "NULLIF(body_line_count(res.body), -1) AS res_body_line_count"
+)
+
+// bodyLineCount returns the number of lines of a text body, i.e. the number of
+// line feeds plus one. Empty bodies have no lines. It returns -1 for binary
+// bodies, which aren't valid UTF-8 or contain NUL bytes. It's registered as an
+// SQL function, which can't return NULL.
+func bodyLineCount(body interface{}) int64 {
+	var b []byte
+
+	switch v := body.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return 0
+	}
+
+	if len(b) == 0 {
+		return 0
+	}
+
+	if !utf8.Valid(b) || bytes.IndexByte(b, 0) != -1 {
+		return -1
+	}
+
+	return int64(bytes.Count(b, []byte{'\n'}) + 1)
+}
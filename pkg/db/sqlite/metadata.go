@@ -0,0 +1,68 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mattn/go-sqlite3"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// SetMetadata sets a metadata value of a request log, overwriting an existing
+// value for the same key.
+func (c *Client) SetMetadata(ctx context.Context, reqID int64, key string, value json.RawMessage) error {
+	if c.db == nil {
+		return proj.ErrNoProject
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	_, err := c.execLogsUpdate(ctx, `INSERT INTO request_metadata (req_id, key, value) VALUES (?, ?, ?)
+		ON CONFLICT (req_id, key) DO UPDATE SET value = excluded.value`,
+		reqID, key, string(value))
+
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintForeignKey {
+		return reqlog.ErrRequestNotFound
+	} else if err != nil {
+		return fmt.Errorf("sqlite: could not upsert metadata: %w", err)
+	}
+
+	return nil
+}
+
+// GetMetadata returns all metadata of a request log, by key.
+func (c *Client) GetMetadata(ctx context.Context, reqID int64) (map[string]json.RawMessage, error) {
+	if c.db == nil {
+		return nil, proj.ErrNoProject
+	}
+
+	rows, err := c.conn.QueryContext(ctx, `SELECT key, value FROM request_metadata WHERE req_id = ? ORDER BY key`, reqID)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not query metadata: %w", err)
+	}
+	defer rows.Close()
+
+	metadata := make(map[string]json.RawMessage)
+
+	for rows.Next() {
+		var key, value string
+
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, fmt.Errorf("sqlite: could not scan row: %w", err)
+		}
+
+		metadata[key] = json.RawMessage(value)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sqlite: could not iterate over rows: %w", err)
+	}
+
+	return metadata, nil
+}
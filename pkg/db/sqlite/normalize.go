@@ -0,0 +1,61 @@
+package sqlite
+
+import (
+	"net/url"
+	"strings"
+)
+
+// DefaultStripQueryParams are query parameters that are commonly used for
+// tracking, and don't affect the requested resource.
+var DefaultStripQueryParams = []string{"utm_*", "fbclid", "gclid", "msclkid"}
+
+// normalizeURL returns a canonical form of u, with query parameters sorted by
+// key and the parameters matching any of strip removed. A pattern in strip
+// matches a key exactly (case-insensitive), or as a prefix when it ends with
+// `*` (e.g. "utm_*"). The order of values of a repeated key is kept.
+func normalizeURL(u *url.URL, strip []string) string {
+	canonical := *u
+
+	if canonical.RawQuery == "" {
+		return canonical.String()
+	}
+
+	query, err := url.ParseQuery(canonical.RawQuery)
+	if err != nil {
+		// Leave unparsable queries as is, rather than guessing.
+		return canonical.String()
+	}
+
+	for key := range query {
+		if stripQueryParam(key, strip) {
+			delete(query, key)
+		}
+	}
+
+	// Encode sorts by key.
+	canonical.RawQuery = query.Encode()
+
+	return canonical.String()
+}
+
+func stripQueryParam(key string, patterns []string) bool {
+	key = strings.ToLower(key)
+
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+
+		if strings.HasSuffix(pattern, "*") {
+			if strings.HasPrefix(key, strings.TrimSuffix(pattern, "*")) {
+				return true
+			}
+
+			continue
+		}
+
+		if key == pattern {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,62 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+)
+
+// MarkAllRequestLogsRead marks all request logs as read, by advancing the read
+// marker to the last request log. It returns the number of request logs that
+// were unread.
+func (c *Client) MarkAllRequestLogsRead(ctx context.Context) (int64, error) {
+	if c.db == nil {
+		return 0, proj.ErrNoProject
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	tx, err := c.beginTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var n int64
+
+	if err := tx.GetContext(ctx, &n, `SELECT COUNT(*) FROM http_requests req WHERE `+unreadExpr); err != nil {
+		return 0, fmt.Errorf("sqlite: could not count unread request logs: %w", err)
+	}
+
+	// The `WHERE` clause avoids a parsing ambiguity of upserts with a `SELECT`.
+	_, err = tx.ExecContext(ctx, `INSERT INTO read_marker (id, last_read_id)
+		SELECT 1, IFNULL(MAX(id), 0) FROM http_requests WHERE TRUE
+		ON CONFLICT (id) DO UPDATE SET last_read_id = excluded.last_read_id`)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not update read marker: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("sqlite: could not commit transaction: %w", err)
+	}
+
+	return n, nil
+}
+
+// CountUnreadRequestLogs returns the number of request logs that were added
+// after the request logs were last marked as read.
+func (c *Client) CountUnreadRequestLogs(ctx context.Context) (int64, error) {
+	if c.db == nil {
+		return 0, proj.ErrNoProject
+	}
+
+	var n int64
+
+	if err := c.conn.GetContext(ctx, &n, `SELECT COUNT(*) FROM http_requests req WHERE `+unreadExpr); err != nil {
+		return 0, fmt.Errorf("sqlite: could not count unread request logs: %w", err)
+	}
+
+	return n, nil
+}
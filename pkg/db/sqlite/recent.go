@@ -0,0 +1,37 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// FindRecentRequestLogs returns the most recent request logs, ordered by ID
+// (descending). Only the ID, method, URL and response status are queried, and
+// headers are never fetched.
+func (c *Client) FindRecentRequestLogs(ctx context.Context, limit int) ([]reqlog.Request, error) {
+	if c.db == nil {
+		return nil, proj.ErrNoProject
+	}
+
+	var dtos []httpRequest
+
+	err := c.conn.SelectContext(ctx, &dtos, `SELECT req.id AS req_id, req.method, req.url,
+		res.id AS res_id, res.status_code, res.status_reason
+		FROM http_requests req
+		LEFT JOIN http_responses res ON req.id = res.req_id
+		ORDER BY req.id DESC
+		LIMIT ?`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not query recent request logs: %w", err)
+	}
+
+	reqLogs := make([]reqlog.Request, len(dtos))
+	for i, dto := range dtos {
+		reqLogs[i] = dto.toRequestLog()
+	}
+
+	return reqLogs, nil
+}
@@ -0,0 +1,76 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// FindRedirectChain returns the request logs of the redirect chain that a
+// request log is part of, from the first request to the last redirect, in
+// order. The links are walked back to the first request, and then forward to
+// the last redirect.
+func (c *Client) FindRedirectChain(ctx context.Context, reqID int64) (reqLogs []reqlog.Request, err error) {
+	if c.db == nil {
+		return nil, proj.ErrNoProject
+	}
+
+	httpReqLogsQuery := parseHTTPRequestLogsQuery(ctx)
+	reqQuery := sq.
+		Select(httpReqLogsQuery.requestCols...).
+		Prefix(`WITH RECURSIVE
+			prev_hops(id, redirected_from) AS (
+				SELECT id, redirected_from FROM http_requests WHERE id = ?
+				UNION
+				SELECT r.id, r.redirected_from FROM http_requests r
+				JOIN prev_hops p ON r.id = p.redirected_from
+			),
+			hops(id) AS (
+				SELECT id FROM prev_hops WHERE redirected_from IS NULL
+				UNION
+				SELECT r.id FROM http_requests r
+				JOIN hops h ON r.redirected_from = h.id
+			)`, reqID).
+		From("http_requests req").
+		Where("req.id IN (SELECT id FROM hops)").
+		OrderBy("req.id")
+
+	if httpReqLogsQuery.joinResponse {
+		reqQuery = reqQuery.LeftJoin("http_responses res ON req.id = res.req_id")
+	}
+
+	sql, args, err := reqQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not parse query: %w", err)
+	}
+
+	rows, err := c.conn.QueryxContext(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not execute query: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var dto httpRequest
+
+		if err := rows.StructScan(&dto); err != nil {
+			return nil, fmt.Errorf("sqlite: could not scan row: %w", err)
+		}
+
+		reqLogs = append(reqLogs, dto.toRequestLog())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sqlite: could not iterate over rows: %w", err)
+	}
+
+	if err := c.queryHeaders(ctx, httpReqLogsQuery, reqLogs); err != nil {
+		return nil, fmt.Errorf("sqlite: could not query headers: %w", err)
+	}
+
+	return reqLogs, nil
+}
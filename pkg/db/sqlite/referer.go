@@ -0,0 +1,63 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// FindParentRequestLogs returns the request logs with a URL that matches the
+// referer of a request log, ordered by ID (descending). The request log itself
+// is never included.
+func (c *Client) FindParentRequestLogs(ctx context.Context, reqID int64) (reqLogs []reqlog.Request, err error) {
+	if c.db == nil {
+		return nil, proj.ErrNoProject
+	}
+
+	httpReqLogsQuery := parseHTTPRequestLogsQuery(ctx)
+	reqQuery := sq.
+		Select(httpReqLogsQuery.requestCols...).
+		From("http_requests req").
+		Where("req.url = (SELECT referer FROM http_requests WHERE id = ?)", reqID).
+		Where("req.id != ?", reqID).
+		OrderBy("req.id DESC")
+
+	if httpReqLogsQuery.joinResponse {
+		reqQuery = reqQuery.LeftJoin("http_responses res ON req.id = res.req_id")
+	}
+
+	sql, args, err := reqQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not parse query: %w", err)
+	}
+
+	rows, err := c.conn.QueryxContext(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not execute query: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var dto httpRequest
+
+		if err := rows.StructScan(&dto); err != nil {
+			return nil, fmt.Errorf("sqlite: could not scan row: %w", err)
+		}
+
+		reqLogs = append(reqLogs, dto.toRequestLog())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sqlite: could not iterate over rows: %w", err)
+	}
+
+	if err := c.queryHeaders(ctx, httpReqLogsQuery, reqLogs); err != nil {
+		return nil, fmt.Errorf("sqlite: could not query headers: %w", err)
+	}
+
+	return reqLogs, nil
+}
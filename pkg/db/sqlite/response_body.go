@@ -0,0 +1,121 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// FindResponseBody returns the response body of a request log, which is read
+// from the database in chunks, so large bodies aren't loaded in memory.
+func (c *Client) FindResponseBody(ctx context.Context, reqID int64) (reqlog.ResponseBody, error) {
+	if c.db == nil {
+		return reqlog.ResponseBody{}, proj.ErrNoProject
+	}
+
+	var res struct {
+		ID        int64     `db:"id"`
+		Size      int64     `db:"size"`
+		Timestamp time.Time `db:"timestamp"`
+	}
+
+	err := c.conn.GetContext(ctx, &res,
+		`SELECT id, IFNULL(length(body), 0) AS size, timestamp FROM http_responses WHERE req_id = ?`, reqID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return reqlog.ResponseBody{}, reqlog.ErrResponseNotFound
+	} else if err != nil {
+		return reqlog.ResponseBody{}, fmt.Errorf("sqlite: could not query response: %w", err)
+	}
+
+	headersStmt, err := c.conn.PrepareContext(ctx, `SELECT key, value FROM http_headers WHERE res_id = ? ORDER BY id`)
+	if err != nil {
+		return reqlog.ResponseBody{}, fmt.Errorf("sqlite: could not prepare statement: %w", err)
+	}
+	defer headersStmt.Close()
+
+	headers, err := findHeaders(ctx, headersStmt, res.ID)
+	if err != nil {
+		return reqlog.ResponseBody{}, fmt.Errorf("sqlite: could not query response headers: %w", err)
+	}
+
+	return reqlog.ResponseBody{
+		Header:    headers,
+		Size:      res.Size,
+		Timestamp: res.Timestamp.UTC(),
+		Body: &blobReader{
+			ctx:  ctx,
+			db:   c.db,
+			id:   res.ID,
+			size: res.Size,
+		},
+	}, nil
+}
+
+// blobReader is an `io.ReadSeeker` for a response body stored in the database.
+type blobReader struct {
+	ctx  context.Context
+	db   *sqlx.DB
+	id   int64
+	size int64
+	off  int64
+}
+
+func (r *blobReader) Read(p []byte) (int, error) {
+	if r.off >= r.size {
+		return 0, io.EOF
+	}
+
+	n := int64(len(p))
+	if remaining := r.size - r.off; n > remaining {
+		n = remaining
+	}
+
+	var chunk []byte
+
+	// Offsets of `substr` are 1-indexed.
+	err := r.db.QueryRowContext(r.ctx,
+		`SELECT substr(body, ?, ?) FROM http_responses WHERE id = ?`, r.off+1, n, r.id).Scan(&chunk)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not read response body: %w", err)
+	}
+
+	if len(chunk) == 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	copied := copy(p, chunk)
+	r.off += int64(copied)
+
+	return copied, nil
+}
+
+func (r *blobReader) Seek(offset int64, whence int) (int64, error) {
+	var off int64
+
+	switch whence {
+	case io.SeekStart:
+		off = offset
+	case io.SeekCurrent:
+		off = r.off + offset
+	case io.SeekEnd:
+		off = r.size + offset
+	default:
+		return 0, errors.New("sqlite: invalid whence")
+	}
+
+	if off < 0 {
+		return 0, errors.New("sqlite: negative position")
+	}
+
+	r.off = off
+
+	return off, nil
+}
@@ -0,0 +1,165 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// savedSearchVersion is the version of the JSON encoding of saved search
+// filters. Increment it when the encoding changes in a way that isn't
+// backwards compatible, and handle older versions in `decodeSavedSearchFilter`.
+const savedSearchVersion = 1
+
+type savedSearch struct {
+	ID        int64        `db:"id"`
+	Name      string       `db:"name"`
+	Version   int          `db:"version"`
+	Filter    []byte       `db:"filter"`
+	CreatedAt sql.NullTime `db:"created_at"`
+	UpdatedAt sql.NullTime `db:"updated_at"`
+}
+
+const savedSearchCols = "id, name, version, filter, created_at, updated_at"
+
+func (c *Client) SaveSearch(ctx context.Context, name string, filter reqlog.FindRequestsFilter) (reqlog.SavedSearch, error) {
+	if c.db == nil {
+		return reqlog.SavedSearch{}, proj.ErrNoProject
+	}
+
+	jsonFilter, err := json.Marshal(filter)
+	if err != nil {
+		return reqlog.SavedSearch{}, fmt.Errorf("sqlite: could not encode filter as JSON: %w", err)
+	}
+
+	now := time.Now().UTC()
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	result, err := c.conn.ExecContext(ctx,
+		`INSERT INTO saved_searches (name, version, filter, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
+		name, savedSearchVersion, jsonFilter, formatTimestamp(now), formatTimestamp(now))
+	if err != nil {
+		return reqlog.SavedSearch{}, fmt.Errorf("sqlite: could not insert saved search: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return reqlog.SavedSearch{}, fmt.Errorf("sqlite: could not get last insert ID: %w", err)
+	}
+
+	return reqlog.SavedSearch{
+		ID:        id,
+		Name:      name,
+		Filter:    filter,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func (c *Client) FindSavedSearches(ctx context.Context) ([]reqlog.SavedSearch, error) {
+	if c.db == nil {
+		return nil, proj.ErrNoProject
+	}
+
+	var dtos []savedSearch
+
+	err := c.conn.SelectContext(ctx, &dtos, "SELECT "+savedSearchCols+" FROM saved_searches ORDER BY name, id")
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not query saved searches: %w", err)
+	}
+
+	savedSearches := make([]reqlog.SavedSearch, len(dtos))
+
+	for i, dto := range dtos {
+		savedSearch, err := dto.toSavedSearch()
+		if err != nil {
+			return nil, err
+		}
+
+		savedSearches[i] = savedSearch
+	}
+
+	return savedSearches, nil
+}
+
+func (c *Client) FindSavedSearchByID(ctx context.Context, id int64) (reqlog.SavedSearch, error) {
+	if c.db == nil {
+		return reqlog.SavedSearch{}, proj.ErrNoProject
+	}
+
+	var dto savedSearch
+
+	err := c.conn.GetContext(ctx, &dto, "SELECT "+savedSearchCols+" FROM saved_searches WHERE id = ?", id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return reqlog.SavedSearch{}, reqlog.ErrSavedSearchNotFound
+	} else if err != nil {
+		return reqlog.SavedSearch{}, fmt.Errorf("sqlite: could not query saved search: %w", err)
+	}
+
+	return dto.toSavedSearch()
+}
+
+func (c *Client) DeleteSavedSearch(ctx context.Context, id int64) error {
+	if c.db == nil {
+		return proj.ErrNoProject
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	result, err := c.conn.ExecContext(ctx, `DELETE FROM saved_searches WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("sqlite: could not delete saved search: %w", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("sqlite: could not get affected rows: %w", err)
+	}
+
+	if n == 0 {
+		return reqlog.ErrSavedSearchNotFound
+	}
+
+	return nil
+}
+
+func (dto savedSearch) toSavedSearch() (reqlog.SavedSearch, error) {
+	filter, err := decodeSavedSearchFilter(dto.Version, dto.Filter)
+	if err != nil {
+		return reqlog.SavedSearch{}, fmt.Errorf("sqlite: could not decode saved search (%v): %w", dto.ID, err)
+	}
+
+	return reqlog.SavedSearch{
+		ID:        dto.ID,
+		Name:      dto.Name,
+		Filter:    filter,
+		CreatedAt: nullTimeToUTC(dto.CreatedAt),
+		UpdatedAt: nullTimeToUTC(dto.UpdatedAt),
+	}, nil
+}
+
+func decodeSavedSearchFilter(version int, data []byte) (reqlog.FindRequestsFilter, error) {
+	var filter reqlog.FindRequestsFilter
+
+	switch version {
+	case 1:
+		// Unknown fields are ignored, and missing fields keep their zero value,
+		// so adding filter fields doesn't require a version bump.
+		if err := json.Unmarshal(data, &filter); err != nil {
+			return reqlog.FindRequestsFilter{}, err
+		}
+	default:
+		return reqlog.FindRequestsFilter{}, fmt.Errorf("unsupported version (%v)", version)
+	}
+
+	return filter, nil
+}
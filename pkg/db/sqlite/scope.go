@@ -0,0 +1,69 @@
+package sqlite
+
+import (
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+// scopeExpr returns an expression that matches request logs like
+// `scope.Scope.Match` does: a request log is in scope if it matches any rule.
+// Regular expressions are evaluated by the `regexp` function. It returns nil
+// if there are no rules with conditions.
+func scopeExpr(rules []scope.Rule) sq.Sqlizer {
+	var ruleExprs sq.Or
+
+	for _, rule := range rules {
+		condExprs := ruleCondExprs(rule)
+
+		// A method constraint must match together with any of the other
+		// conditions, or on its own if the rule has no other conditions.
+		switch {
+		case len(rule.Methods) > 0 && len(condExprs) > 0:
+			ruleExprs = append(ruleExprs, sq.And{sq.Eq{"req.method": rule.Methods}, condExprs})
+		case len(rule.Methods) > 0:
+			ruleExprs = append(ruleExprs, sq.Eq{"req.method": rule.Methods})
+		default:
+			ruleExprs = append(ruleExprs, condExprs...)
+		}
+	}
+
+	if len(ruleExprs) == 0 {
+		return nil
+	}
+
+	return ruleExprs
+}
+
+// ruleCondExprs returns the URL, header and body conditions of a rule, of
+// which any must match.
+func ruleCondExprs(rule scope.Rule) sq.Or {
+	var condExprs sq.Or
+
+	if rule.URL != nil {
+		condExprs = append(condExprs, sq.Expr("regexp(?, req.url)", rule.URL.String()))
+	}
+
+	// When both header key and value are set, both must match the same
+	// header line.
+	var headerCond sq.And
+
+	if rule.Header.Key != nil {
+		headerCond = append(headerCond, sq.Expr("regexp(?, h.key)", rule.Header.Key.String()))
+	}
+
+	if rule.Header.Value != nil {
+		headerCond = append(headerCond, sq.Expr("regexp(?, h.value)", rule.Header.Value.String()))
+	}
+
+	if len(headerCond) > 0 {
+		condExprs = append(condExprs, sq.Expr(
+			"EXISTS (SELECT 1 FROM http_headers h WHERE h.req_id = req.id AND ?)", headerCond))
+	}
+
+	if rule.Body != nil {
+		condExprs = append(condExprs, sq.Expr("regexp(?, IFNULL(req.body, ''))", rule.Body.String()))
+	}
+
+	return condExprs
+}
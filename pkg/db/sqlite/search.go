@@ -28,14 +28,19 @@ var stringLiteralMap = map[string]string{
 	// TODO: http_headers
 }
 
-func parseSearchExpr(expr search.Expression) (sq.Sqlizer, error) {
+// parseSearchExpr translates a search expression into an SQL expression. When
+// caseInsensitive is set, comparisons use `COLLATE NOCASE`, `LOWER()` and
+// case-insensitive regular expressions. Note that this prevents SQLite from
+// using indexes on the compared columns, and that only ASCII characters are
+// folded.
+func parseSearchExpr(expr search.Expression, caseInsensitive bool) (sq.Sqlizer, error) {
 	switch e := expr.(type) {
 	case *search.PrefixExpression:
 		return parsePrefixExpr(e)
 	case *search.InfixExpression:
-		return parseInfixExpr(e)
+		return parseInfixExpr(e, caseInsensitive)
 	case *search.StringLiteral:
-		return parseStringLiteral(e)
+		return parseStringLiteral(e, caseInsensitive)
 	default:
 		return nil, fmt.Errorf("expression type (%v) not supported", expr)
 	}
@@ -51,27 +56,27 @@ func parsePrefixExpr(expr *search.PrefixExpression) (sq.Sqlizer, error) {
 	}
 }
 
-func parseInfixExpr(expr *search.InfixExpression) (sq.Sqlizer, error) {
+func parseInfixExpr(expr *search.InfixExpression, caseInsensitive bool) (sq.Sqlizer, error) {
 	switch expr.Operator {
 	case search.TokOpAnd:
-		left, err := parseSearchExpr(expr.Left)
+		left, err := parseSearchExpr(expr.Left, caseInsensitive)
 		if err != nil {
 			return nil, err
 		}
 
-		right, err := parseSearchExpr(expr.Right)
+		right, err := parseSearchExpr(expr.Right, caseInsensitive)
 		if err != nil {
 			return nil, err
 		}
 
 		return sq.And{left, right}, nil
 	case search.TokOpOr:
-		left, err := parseSearchExpr(expr.Left)
+		left, err := parseSearchExpr(expr.Left, caseInsensitive)
 		if err != nil {
 			return nil, err
 		}
 
-		right, err := parseSearchExpr(expr.Right)
+		right, err := parseSearchExpr(expr.Right, caseInsensitive)
 		if err != nil {
 			return nil, err
 		}
@@ -94,6 +99,10 @@ func parseInfixExpr(expr *search.InfixExpression) (sq.Sqlizer, error) {
 		return nil, fmt.Errorf("invalid string literal: %v", left)
 	}
 
+	if caseInsensitive {
+		return parseCaseInsensitiveComparison(expr.Operator, mappedLeft, right.Value)
+	}
+
 	switch expr.Operator {
 	case search.TokOpEq:
 		return sq.Eq{mappedLeft: right.Value}, nil
@@ -116,7 +125,34 @@ func parseInfixExpr(expr *search.InfixExpression) (sq.Sqlizer, error) {
 	}
 }
 
-func parseStringLiteral(strLiteral *search.StringLiteral) (sq.Sqlizer, error) {
+func parseCaseInsensitiveComparison(op search.TokenType, column, value string) (sq.Sqlizer, error) {
+	var sqlOp string
+
+	switch op {
+	case search.TokOpEq:
+		sqlOp = "="
+	case search.TokOpNotEq:
+		sqlOp = "<>"
+	case search.TokOpGt:
+		sqlOp = ">"
+	case search.TokOpLt:
+		sqlOp = "<"
+	case search.TokOpGtEq:
+		sqlOp = ">="
+	case search.TokOpLtEq:
+		sqlOp = "<="
+	case search.TokOpRe:
+		return sq.Expr(fmt.Sprintf("regexp(?, %v)", column), "(?i)"+value), nil
+	case search.TokOpNotRe:
+		return sq.Expr(fmt.Sprintf("NOT regexp(?, %v)", column), "(?i)"+value), nil
+	default:
+		return nil, errors.New("unsupported operator")
+	}
+
+	return sq.Expr(fmt.Sprintf("%v %v ? COLLATE NOCASE", column, sqlOp), value), nil
+}
+
+func parseStringLiteral(strLiteral *search.StringLiteral, caseInsensitive bool) (sq.Sqlizer, error) {
 	// Sorting is not necessary, but makes it easier to do assertions in tests.
 	sortedKeys := make([]string, 0, len(stringLiteralMap))
 
@@ -128,6 +164,11 @@ func parseStringLiteral(strLiteral *search.StringLiteral) (sq.Sqlizer, error) {
 
 	or := make(sq.Or, len(stringLiteralMap))
 	for i, value := range sortedKeys {
+		if caseInsensitive {
+			or[i] = sq.Expr(fmt.Sprintf("LOWER(%v) LIKE LOWER(?)", value), "%"+strLiteral.Value+"%")
+			continue
+		}
+
 		or[i] = sq.Like{value: "%" + strLiteral.Value + "%"}
 	}
 
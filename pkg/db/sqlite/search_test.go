@@ -198,7 +198,7 @@ func TestParseSearchExpr(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got, err := parseSearchExpr(tt.searchExpr)
+			got, err := parseSearchExpr(tt.searchExpr, false)
 			assertError(t, tt.expectedError, err)
 			if !reflect.DeepEqual(tt.expectedSqlizer, got) {
 				t.Errorf("expected: %#v, got: %#v", tt.expectedSqlizer, got)
@@ -0,0 +1,82 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// hasCookie reports whether a `Cookie` header value has a cookie with the name
+// and value. It's registered as an SQL function.
+func hasCookie(header, name, value string) bool {
+	req := http.Request{Header: http.Header{"Cookie": []string{header}}}
+
+	for _, cookie := range req.Cookies() {
+		if cookie.Name == name && cookie.Value == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FindRequestLogsBySession returns the request logs with a `Cookie` header that
+// has a cookie with the name and value, ordered by ID (descending).
+func (c *Client) FindRequestLogsBySession(
+	ctx context.Context,
+	cookieName, value string,
+) (reqLogs []reqlog.Request, err error) {
+	if c.db == nil {
+		return nil, proj.ErrNoProject
+	}
+
+	httpReqLogsQuery := parseHTTPRequestLogsQuery(ctx)
+	reqQuery := sq.
+		Select(httpReqLogsQuery.requestCols...).
+		From("http_requests req").
+		Where(`EXISTS (
+			SELECT 1 FROM http_headers h
+			WHERE h.req_id = req.id AND h.key = 'Cookie' AND has_cookie(h.value, ?, ?)
+		)`, cookieName, value).
+		OrderBy("req.id DESC")
+
+	if httpReqLogsQuery.joinResponse {
+		reqQuery = reqQuery.LeftJoin("http_responses res ON req.id = res.req_id")
+	}
+
+	sql, args, err := reqQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not parse query: %w", err)
+	}
+
+	rows, err := c.conn.QueryxContext(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not execute query: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var dto httpRequest
+
+		if err := rows.StructScan(&dto); err != nil {
+			return nil, fmt.Errorf("sqlite: could not scan row: %w", err)
+		}
+
+		reqLogs = append(reqLogs, dto.toRequestLog())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sqlite: could not iterate over rows: %w", err)
+	}
+
+	if err := c.queryHeaders(ctx, httpReqLogsQuery, reqLogs); err != nil {
+		return nil, fmt.Errorf("sqlite: could not query headers: %w", err)
+	}
+
+	return reqLogs, nil
+}
@@ -2,7 +2,9 @@ package sqlite
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,14 +14,19 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/99designs/gqlgen/graphql"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
 	"github.com/mattn/go-sqlite3"
 
+	"github.com/dstotijn/hetty/pkg/db"
 	"github.com/dstotijn/hetty/pkg/proj"
 	"github.com/dstotijn/hetty/pkg/reqlog"
 	"github.com/dstotijn/hetty/pkg/scope"
@@ -38,11 +45,166 @@ var regexpFn = func(pattern string, value interface{}) (bool, error) {
 	}
 }
 
-// Client implements reqlog.Repository.
+// urlPathFn and urlQueryFn return the path and raw query of a stored URL. They
+// are used for filters that must match a specific URL component, e.g. to
+// anchor a path prefix at the start of the path instead of the start of the
+// URL. urlHostFn returns the host (and port, if any), for grouping by host.
+var (
+	urlPathFn = func(rawURL string) string {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return ""
+		}
+
+		return u.Path
+	}
+	urlQueryFn = func(rawURL string) string {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return ""
+		}
+
+		return u.RawQuery
+	}
+	urlHostFn = func(rawURL string) string {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return ""
+		}
+
+		return strings.ToLower(u.Host)
+	}
+)
+
+// fingerprint returns a hash of the fields that identify duplicate requests.
+// It's registered as an SQL function as well, so existing rows can be
+// fingerprinted identically.
+func fingerprint(method, rawURL string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(rawURL))
+	h.Write([]byte{0})
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// timestampLayout is the format timestamps are stored in. It has a fixed number
+// of fractional digits, so timestamps keep nanosecond precision and sort
+// chronologically as text. The driver parses it when scanning `DATETIME`
+// columns.
+const timestampLayout = "2006-01-02T15:04:05.000000000Z"
+
+func formatTimestamp(t time.Time) string {
+	return t.UTC().Format(timestampLayout)
+}
+
+// normalizeTimestamp reformats a stored timestamp in any of the formats the
+// driver supports to timestampLayout. Values that can't be parsed are returned
+// as is. It's registered as an SQL function, for migrating existing rows.
+func normalizeTimestamp(value string) string {
+	// Like the driver, treat a trailing "Z" as UTC.
+	trimmed := strings.TrimSuffix(value, "Z")
+
+	for _, layout := range sqlite3.SQLiteTimestampFormats {
+		if t, err := time.ParseInLocation(layout, trimmed, time.UTC); err == nil {
+			return formatTimestamp(t)
+		}
+	}
+
+	return value
+}
+
+// Default connection pool settings and header limits, used when Config leaves
+// them unset.
+const (
+	defaultMaxOpenConns         = 16
+	defaultMaxIdleConns         = 4
+	defaultBusyTimeout          = 5 * time.Second
+	defaultMaxHeaders           = 1000
+	defaultMaxHeaderValueLength = 64 << 10
+)
+
+// Client implements db.Repository.
 type Client struct {
 	db            *sqlx.DB
 	dbPath        string
 	activeProject string
+	config        Config
+
+	// conn is used for queries. It's the database, or a transaction for the
+	// client passed to the callback of WithTx.
+	conn       dbConn
+	inTx       bool
+	savepoints int
+
+	// SQLite allows many concurrent readers, but only a single writer. Writes
+	// are serialized in-process, so that concurrent writers queue up here
+	// instead of contending for the database lock (and failing with
+	// `SQLITE_BUSY`).
+	writeMu sync.Mutex
+
+	// stopEvictionCh and evictionDone control the background check that
+	// enforces Config.MaxDBSize, while a project is open.
+	stopEvictionCh chan struct{}
+	evictionDone   chan struct{}
+}
+
+// Config is used to configure a Client.
+type Config struct {
+	// ProjectsPath is the directory where project database files are stored.
+	ProjectsPath string
+
+	// Connection pool settings, applied when a project database is opened.
+	// Because writes are serialized and the database runs in WAL mode, extra
+	// connections are only used by concurrent readers.
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+
+	// BusyTimeout is how long SQLite waits on a locked database before
+	// returning `SQLITE_BUSY`, e.g. when another process has the database open.
+	BusyTimeout time.Duration
+
+	// CollapseDuplicateHeaders stores exactly duplicate header lines (same key
+	// and value) of a request or response only once. By default, headers are
+	// stored as received, so repeated lines read back as repeated values, in
+	// their original order.
+	CollapseDuplicateHeaders bool
+
+	// MaxHeaders is the maximum number of header lines stored per request or
+	// response. MaxHeaderValueLength is the maximum length (in bytes) of a
+	// stored header value; longer values are truncated. When either limit is
+	// hit, the request or response log is flagged as having truncated headers.
+	MaxHeaders           int
+	MaxHeaderValueLength int
+
+	// SkipBodyContentTypes is a list of content type prefixes (e.g. "image/")
+	// of responses whose bodies aren't stored. Their headers and metadata are
+	// still stored. Matching is case-insensitive. By default, all bodies are
+	// stored.
+	SkipBodyContentTypes []string
+
+	// NormalizeURLs makes request logs store a canonical URL alongside the
+	// original one, with query parameters sorted by key and the parameters in
+	// StripQueryParams removed. The canonical URL is used for the fingerprint,
+	// so requests that only differ in parameter order or tracking parameters
+	// are grouped as duplicates.
+	NormalizeURLs bool
+	// StripQueryParams is a list of query parameter keys that are removed from
+	// canonical URLs, matched case-insensitively. A key ending with `*` matches
+	// as a prefix (e.g. "utm_*"). It's only used if NormalizeURLs is set.
+	StripQueryParams []string
+
+	// MaxDBSize is the maximum size (in bytes) of a project database. A
+	// background check compares the database size with it, and when it's
+	// exceeded, deletes the oldest request logs until the database fits, and
+	// vacuums it. Zero means unlimited.
+	MaxDBSize int64
+	// SizeCheckInterval is how often the database size is checked. Defaults to
+	// one minute. It's only used if MaxDBSize is set.
+	SizeCheckInterval time.Duration
 }
 
 type httpRequestLogsQuery struct {
@@ -55,20 +217,81 @@ type httpRequestLogsQuery struct {
 func init() {
 	sql.Register("sqlite3_with_regexp", &sqlite3.SQLiteDriver{
 		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
-			return conn.RegisterFunc("regexp", regexpFn, false)
+			if err := conn.RegisterFunc("regexp", regexpFn, false); err != nil {
+				return err
+			}
+			if err := conn.RegisterFunc("url_path", urlPathFn, true); err != nil {
+				return err
+			}
+			if err := conn.RegisterFunc("url_host", urlHostFn, true); err != nil {
+				return err
+			}
+			if err := conn.RegisterFunc("normalize_timestamp", normalizeTimestamp, true); err != nil {
+				return err
+			}
+			if err := conn.RegisterFunc("fingerprint", fingerprint, true); err != nil {
+				return err
+			}
+			if err := conn.RegisterFunc("json_path_match", jsonPathMatch, true); err != nil {
+				return err
+			}
+			if err := conn.RegisterFunc("has_cookie", hasCookie, true); err != nil {
+				return err
+			}
+			if err := conn.RegisterFunc("body_line_count", bodyLineCount, true); err != nil {
+				return err
+			}
+			if err := conn.RegisterFunc("body_hash", bodyHash, true); err != nil {
+				return err
+			}
+
+			return conn.RegisterFunc("url_query", urlQueryFn, true)
 		},
 	})
+
+	// The data source name of the `sqlite` driver is the projects path.
+	db.Register("sqlite", func(dsn string, opts db.Options) (db.Repository, error) {
+		return New(Config{
+			ProjectsPath:         dsn,
+			SkipBodyContentTypes: opts.SkipBodyContentTypes,
+			NormalizeURLs:        opts.NormalizeURLs,
+			StripQueryParams:     opts.StripQueryParams,
+			MaxDBSize:            opts.MaxDBSize,
+			SizeCheckInterval:    opts.SizeCheckInterval,
+		})
+	})
 }
 
-func New(dbPath string) (*Client, error) {
-	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
-		if err := os.MkdirAll(dbPath, 0755); err != nil {
+func New(cfg Config) (*Client, error) {
+	if _, err := os.Stat(cfg.ProjectsPath); os.IsNotExist(err) {
+		if err := os.MkdirAll(cfg.ProjectsPath, 0755); err != nil {
 			return nil, fmt.Errorf("proj: could not create project directory: %w", err)
 		}
 	}
 
+	if cfg.MaxOpenConns == 0 {
+		cfg.MaxOpenConns = defaultMaxOpenConns
+	}
+
+	if cfg.MaxIdleConns == 0 {
+		cfg.MaxIdleConns = defaultMaxIdleConns
+	}
+
+	if cfg.BusyTimeout == 0 {
+		cfg.BusyTimeout = defaultBusyTimeout
+	}
+
+	if cfg.MaxHeaders == 0 {
+		cfg.MaxHeaders = defaultMaxHeaders
+	}
+
+	if cfg.MaxHeaderValueLength == 0 {
+		cfg.MaxHeaderValueLength = defaultMaxHeaderValueLength
+	}
+
 	return &Client{
-		dbPath: dbPath,
+		dbPath: cfg.ProjectsPath,
+		config: cfg,
 	}, nil
 }
 
@@ -80,6 +303,14 @@ func (c *Client) OpenProject(name string) error {
 
 	opts := make(url.Values)
 	opts.Set("_foreign_keys", "1")
+	opts.Set("_journal_mode", "WAL")
+	// Make `LIKE` case-sensitive, for consistency with `=` and `regexp`. By
+	// default, SQLite matches ASCII characters case-insensitively with `LIKE`,
+	// so without this option substring searches (search expressions, path
+	// prefix, query and header values) would ignore case. Case-insensitive
+	// matching is opt-in, per query, via `FindRequestsFilter.CaseInsensitive`.
+	opts.Set("_cslike", "1")
+	opts.Set("_busy_timeout", strconv.FormatInt(c.config.BusyTimeout.Milliseconds(), 10))
 
 	dbPath := filepath.Join(c.dbPath, name+".db")
 	dsn := fmt.Sprintf("file:%v?%v", dbPath, opts.Encode())
@@ -89,6 +320,10 @@ func (c *Client) OpenProject(name string) error {
 		return fmt.Errorf("sqlite: could not open database: %w", err)
 	}
 
+	db.SetMaxOpenConns(c.config.MaxOpenConns)
+	db.SetMaxIdleConns(c.config.MaxIdleConns)
+	db.SetConnMaxLifetime(c.config.ConnMaxLifetime)
+
 	if err := db.Ping(); err != nil {
 		return fmt.Errorf("sqlite: could not ping database: %w", err)
 	}
@@ -98,8 +333,13 @@ func (c *Client) OpenProject(name string) error {
 	}
 
 	c.db = db
+	c.conn = db
 	c.activeProject = name
 
+	if c.config.MaxDBSize > 0 {
+		c.startEviction()
+	}
+
 	return nil
 }
 
@@ -109,14 +349,19 @@ func (c *Client) Projects() ([]proj.Project, error) {
 		return nil, fmt.Errorf("sqlite: could not read projects directory: %w", err)
 	}
 
-	projects := make([]proj.Project, len(files))
+	projects := make([]proj.Project, 0, len(files))
+
+	for _, file := range files {
+		// Skip non database files, e.g. WAL and shared memory files.
+		if file.IsDir() || filepath.Ext(file.Name()) != ".db" {
+			continue
+		}
 
-	for i, file := range files {
 		projName := strings.TrimSuffix(file.Name(), ".db")
-		projects[i] = proj.Project{
+		projects = append(projects, proj.Project{
 			Name:     projName,
 			IsActive: c.activeProject == projName,
-		}
+		})
 	}
 
 	return projects, nil
@@ -159,6 +404,44 @@ func prepareSchema(db *sqlx.DB) error {
 		return fmt.Errorf("could not create http_headers table: %w", err)
 	}
 
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS saved_searches (
+		id INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		version INTEGER NOT NULL,
+		filter TEXT NOT NULL
+	)`)
+	if err != nil {
+		return fmt.Errorf("could not create saved_searches table: %w", err)
+	}
+
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS request_metadata (
+		req_id INTEGER NOT NULL REFERENCES http_requests(id) ON DELETE CASCADE,
+		key TEXT NOT NULL,
+		value TEXT NOT NULL,
+		PRIMARY KEY (req_id, key)
+	)`)
+	if err != nil {
+		return fmt.Errorf("could not create request_metadata table: %w", err)
+	}
+
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS tags (
+		id INTEGER PRIMARY KEY,
+		name TEXT NOT NULL UNIQUE,
+		color TEXT
+	)`)
+	if err != nil {
+		return fmt.Errorf("could not create tags table: %w", err)
+	}
+
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS http_request_tags (
+		req_id INTEGER NOT NULL REFERENCES http_requests(id) ON DELETE CASCADE,
+		tag_id INTEGER NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+		PRIMARY KEY (req_id, tag_id)
+	)`)
+	if err != nil {
+		return fmt.Errorf("could not create http_request_tags table: %w", err)
+	}
+
 	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS settings (
 		module TEXT PRIMARY KEY,
 		settings TEXT
@@ -167,6 +450,181 @@ func prepareSchema(db *sqlx.DB) error {
 		return fmt.Errorf("could not create settings table: %w", err)
 	}
 
+	// The read marker is a single row, with the ID of the last request log
+	// that was marked as read.
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS read_marker (
+		id INTEGER PRIMARY KEY CHECK (id = 1),
+		last_read_id INTEGER NOT NULL
+	)`)
+	if err != nil {
+		return fmt.Errorf("could not create read_marker table: %w", err)
+	}
+
+	// The request log sequence is a single row, with the highest ID that was
+	// assigned to a request log, so that IDs of deleted request logs aren't
+	// reused.
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS http_request_seq (
+		id INTEGER PRIMARY KEY CHECK (id = 1),
+		seq INTEGER NOT NULL
+	)`)
+	if err != nil {
+		return fmt.Errorf("could not create http_request_seq table: %w", err)
+	}
+
+	// The logs version is a single row, with the number of updates of existing
+	// request logs (e.g. tags), for detecting them in FindLogsVersion.
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS logs_version (
+		id INTEGER PRIMARY KEY CHECK (id = 1),
+		changes INTEGER NOT NULL
+	)`)
+	if err != nil {
+		return fmt.Errorf("could not create logs_version table: %w", err)
+	}
+
+	for _, col := range addedColumns {
+		if err := addColumnIfNotExists(db, col.table, col.name, col.definition, col.backfill); err != nil {
+			return fmt.Errorf("could not add column %v.%v: %w", col.table, col.name, err)
+		}
+	}
+
+	for _, stmt := range indexes {
+		if _, err := db.Exec(stmt); err != nil {
+			return fmt.Errorf("could not create index: %w", err)
+		}
+	}
+
+	if err := migrate(db); err != nil {
+		return fmt.Errorf("could not migrate data: %w", err)
+	}
+
+	return nil
+}
+
+// migrations are statements that rewrite existing data. They run once per
+// database, in order. The number of applied migrations is stored as the
+// `user_version` of the database, so migrations must only ever be appended.
+var migrations = []string{
+	`UPDATE http_requests SET timestamp = normalize_timestamp(timestamp) WHERE typeof(timestamp) = 'text'`,
+	`UPDATE http_responses SET timestamp = normalize_timestamp(timestamp) WHERE typeof(timestamp) = 'text'`,
+}
+
+func migrate(db *sqlx.DB) error {
+	var version int
+
+	if err := db.Get(&version, `PRAGMA user_version`); err != nil {
+		return fmt.Errorf("could not query user version: %w", err)
+	}
+
+	if version >= len(migrations) {
+		return nil
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("could not start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, stmt := range migrations[version:] {
+		if _, err := tx.Exec(stmt); err != nil {
+			return fmt.Errorf("could not execute migration: %w", err)
+		}
+	}
+
+	// Pragma values can't be bound as parameters.
+	if _, err := tx.Exec(fmt.Sprintf("PRAGMA user_version = %d", len(migrations))); err != nil {
+		return fmt.Errorf("could not update user version: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+var indexes = []string{
+	`CREATE INDEX IF NOT EXISTS http_requests_fingerprint_idx ON http_requests (fingerprint)`,
+	`CREATE INDEX IF NOT EXISTS http_requests_referer_idx ON http_requests (referer)`,
+	`CREATE INDEX IF NOT EXISTS http_requests_url_idx ON http_requests (url)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS http_requests_idempotency_key_idx ON http_requests (idempotency_key)`,
+	`CREATE INDEX IF NOT EXISTS http_requests_conn_id_idx ON http_requests (conn_id)`,
+	`CREATE INDEX IF NOT EXISTS http_requests_body_hash_idx ON http_requests (body_hash)`,
+	`CREATE INDEX IF NOT EXISTS http_responses_body_hash_idx ON http_responses (body_hash)`,
+	`CREATE INDEX IF NOT EXISTS http_requests_redirected_from_idx ON http_requests (redirected_from)`,
+	`CREATE INDEX IF NOT EXISTS http_requests_starred_idx ON http_requests (starred)`,
+}
+
+// addedColumns are columns that were added to tables after their initial
+// schema. They are added to existing databases when a project is opened. The
+// optional backfill statement runs once, right after a column is added.
+var addedColumns = []struct {
+	table      string
+	name       string
+	definition string
+	backfill   string
+}{
+	{"http_requests", "tls_version", "INTEGER", ""},
+	{"http_requests", "tls_cipher", "INTEGER", ""},
+	{"http_requests", "fingerprint", "TEXT", "UPDATE http_requests SET fingerprint = fingerprint(method, url, IFNULL(body, X''))"},
+	{"http_requests", "host_header", "TEXT", ""},
+	{
+		"http_requests", "referer", "TEXT",
+		`UPDATE http_requests SET referer = (
+			SELECT h.value FROM http_headers h
+			WHERE h.req_id = http_requests.id AND h.key = 'Referer'
+			ORDER BY h.id LIMIT 1
+		)`,
+	},
+	{"http_responses", "content_length_mismatch", "BOOLEAN", ""},
+	{"http_responses", "body_skipped", "BOOLEAN", ""},
+	{"http_requests", "headers_truncated", "BOOLEAN", ""},
+	{"http_requests", "server_addr", "TEXT", ""},
+	{"http_responses", "headers_truncated", "BOOLEAN", ""},
+	{"http_requests", "body_truncated", "BOOLEAN", ""},
+	{"http_responses", "body_truncated", "BOOLEAN", ""},
+	{"settings", "created_at", "DATETIME", ""},
+	{"settings", "updated_at", "DATETIME", ""},
+	{"saved_searches", "created_at", "DATETIME", ""},
+	{"saved_searches", "updated_at", "DATETIME", ""},
+	{"http_requests", "canonical_url", "TEXT", ""},
+	{"http_requests", "idempotency_key", "TEXT", ""},
+	{"http_responses", "timings", "TEXT", ""},
+	{"http_requests", "is_baseline", "BOOLEAN", ""},
+	{"http_requests", "conn_id", "INTEGER", ""},
+	{"http_requests", "grpc_method", "TEXT", ""},
+	{"http_responses", "cache_status", "TEXT", ""},
+	{"http_requests", "sni", "TEXT", ""},
+	{"http_requests", "body_hash", "TEXT", "UPDATE http_requests SET body_hash = NULLIF(body_hash(body), '')"},
+	{"http_responses", "body_hash", "TEXT", "UPDATE http_responses SET body_hash = NULLIF(body_hash(body), '')"},
+	{"http_requests", "listener", "TEXT", ""},
+	{"http_requests", "redirected_from", "INTEGER REFERENCES http_requests(id) ON DELETE SET NULL", ""},
+	{"http_requests", "client_cert", "BOOLEAN", ""},
+	{"http_requests", "starred", "BOOLEAN NOT NULL DEFAULT FALSE", ""},
+}
+
+func addColumnIfNotExists(db *sqlx.DB, table, column, definition, backfill string) error {
+	var count int
+
+	err := db.Get(&count, `SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?`, table, column)
+	if err != nil {
+		return fmt.Errorf("could not query table info: %w", err)
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	if _, err := db.Exec(fmt.Sprintf("ALTER TABLE %v ADD COLUMN %v %v", table, column, definition)); err != nil {
+		return fmt.Errorf("could not alter table: %w", err)
+	}
+
+	if backfill != "" {
+		if _, err := db.Exec(backfill); err != nil {
+			return fmt.Errorf("could not backfill column: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -176,52 +634,124 @@ func (c *Client) Close() error {
 		return nil
 	}
 
+	c.stopEviction()
+
 	if err := c.db.Close(); err != nil {
 		return fmt.Errorf("sqlite: could not close database: %w", err)
 	}
 
 	c.db = nil
+	c.conn = nil
 	c.activeProject = ""
 
 	return nil
 }
 
+// Ping verifies the connection to the project database.
+func (c *Client) Ping(ctx context.Context) error {
+	if c.db == nil {
+		return proj.ErrNoProject
+	}
+
+	if err := c.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("sqlite: could not ping database: %w", err)
+	}
+
+	return nil
+}
+
 func (c *Client) DeleteProject(name string) error {
-	if err := os.Remove(filepath.Join(c.dbPath, name+".db")); err != nil {
+	dbPath := filepath.Join(c.dbPath, name+".db")
+
+	if err := os.Remove(dbPath); err != nil {
 		return fmt.Errorf("sqlite: could not remove database file: %w", err)
 	}
 
+	// Remove WAL and shared memory files, if they were left behind.
+	for _, suffix := range []string{"-wal", "-shm"} {
+		if err := os.Remove(dbPath + suffix); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("sqlite: could not remove database file: %w", err)
+		}
+	}
+
 	return nil
 }
 
 var reqFieldToColumnMap = map[string]string{
-	"proto":     "proto AS req_proto",
-	"url":       "url",
-	"method":    "method",
-	"body":      "body AS req_body",
-	"timestamp": "timestamp AS req_timestamp",
+	"proto":            "proto AS req_proto",
+	"url":              "url",
+	"method":           "method",
+	"body":             "body AS req_body",
+	"timestamp":        "timestamp AS req_timestamp",
+	"tlsVersion":       "tls_version",
+	"tlsCipher":        "tls_cipher",
+	"fingerprint":      "fingerprint",
+	"hostHeader":       "host_header",
+	"referer":          "referer",
+	"headersTruncated": "headers_truncated AS req_headers_truncated",
+	"serverAddr":       "server_addr",
+	"bodyTruncated":    "body_truncated AS req_body_truncated",
+	"canonicalUrl":     "canonical_url",
+	"isBaseline":       "is_baseline",
+	"connectionId":     "conn_id",
+	"grpcMethod":       "grpc_method",
+	"sni":              "sni",
+	"bodyHash":         "body_hash AS req_body_hash",
+	"listener":         "listener",
+	"redirectedFromId": "redirected_from",
+	"clientCert":       "client_cert",
+	"starred":          "starred",
 }
 
 var resFieldToColumnMap = map[string]string{
-	"requestId":    "req_id AS res_req_id",
-	"proto":        "proto AS res_proto",
-	"statusCode":   "status_code",
-	"statusReason": "status_reason",
-	"body":         "body AS res_body",
-	"timestamp":    "timestamp AS res_timestamp",
+	"requestId":             "req_id AS res_req_id",
+	"proto":                 "proto AS res_proto",
+	"statusCode":            "status_code",
+	"statusReason":          "status_reason",
+	"body":                  "body AS res_body",
+	"timestamp":             "timestamp AS res_timestamp",
+	"contentLengthMismatch": "content_length_mismatch",
+	"bodySkipped":           "body_skipped",
+	"headersTruncated":      "headers_truncated AS res_headers_truncated",
+	"bodyTruncated":         "body_truncated AS res_body_truncated",
+	"timings":               "timings",
+	"cacheStatus":           "cache_status",
+	"bodyHash":              "body_hash AS res_body_hash",
 }
 
+// Body sizes are computed by the database, so they can be queried without
+// transferring the bodies themselves.
+const (
+	reqBodySizeCol = "IFNULL(LENGTH(req.body), 0) AS req_body_size"
+	resBodySizeCol = "IFNULL(LENGTH(res.body), 0) AS res_body_size"
+)
+
+// Request logs are unread if they were added after the last request log that
+// was marked as read.
+const (
+	unreadExpr = "req.id > IFNULL((SELECT last_read_id FROM read_marker WHERE id = 1), 0)"
+	unreadCol  = unreadExpr + " AS unread"
+)
+
 var headerFieldToColumnMap = map[string]string{
 	"key":   "key",
 	"value": "value",
 }
 
+// bodyHeaderCols are the header columns queried when a body is requested, so
+// it can be decoded. They are also queried for paginated headers, which are
+// returned whole.
+var bodyHeaderCols = []string{"key", "value"}
+
 func (c *Client) ClearRequestLogs(ctx context.Context) error {
 	if c.db == nil {
 		return proj.ErrNoProject
 	}
 
-	_, err := c.db.Exec("DELETE FROM http_requests")
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	_, err := c.conn.Exec("DELETE FROM http_requests")
 	if err != nil {
 		return fmt.Errorf("sqlite: could not delete requests: %w", err)
 	}
@@ -240,53 +770,91 @@ func (c *Client) FindRequestLogs(
 
 	httpReqLogsQuery := parseHTTPRequestLogsQuery(ctx)
 
-	reqQuery := sq.
-		Select(httpReqLogsQuery.requestCols...).
-		From("http_requests req").
-		OrderBy("req.id DESC")
-	if httpReqLogsQuery.joinResponse {
-		reqQuery = reqQuery.LeftJoin("http_responses res ON req.id = res.req_id")
+	reqQuery, err := findRequestLogsQuery(httpReqLogsQuery, filter, scope)
+	if err != nil {
+		return nil, err
 	}
 
-	if filter.OnlyInScope && scope != nil {
-		var ruleExpr []sq.Sqlizer
+	return c.queryRequestLogs(ctx, httpReqLogsQuery, reqQuery)
+}
 
-		for _, rule := range scope.Rules() {
-			if rule.URL != nil {
-				ruleExpr = append(ruleExpr, sq.Expr("regexp(?, req.url)", rule.URL.String()))
-			}
-		}
+// StreamRequestLogs calls fn for every request log that matches the filter.
+// Rows are read in pages, so memory usage stays flat regardless of the number
+// of results. Iteration stops when fn returns an error.
+func (c *Client) StreamRequestLogs(
+	ctx context.Context,
+	filter reqlog.FindRequestsFilter,
+	scope *scope.Scope,
+	fn func(reqlog.Request) error,
+) error {
+	if c.db == nil {
+		return proj.ErrNoProject
+	}
 
-		if len(ruleExpr) > 0 {
-			reqQuery = reqQuery.Where(sq.Or(ruleExpr))
-		}
+	httpReqLogsQuery := parseHTTPRequestLogsQuery(ctx)
+
+	reqQuery, err := findRequestLogsQuery(httpReqLogsQuery, filter, scope)
+	if err != nil {
+		return err
 	}
 
-	if filter.SearchExpr != nil {
-		sqlizer, err := parseSearchExpr(filter.SearchExpr)
+	// Pages are queried by (descending) ID, rather than with an offset, so
+	// rows added while streaming don't shift the next page.
+	pageQuery := reqQuery.Limit(maxIDsPerQuery)
+
+	for {
+		reqLogs, err := c.queryRequestLogs(ctx, httpReqLogsQuery, pageQuery)
 		if err != nil {
-			return nil, fmt.Errorf("sqlite: could not parse search expression: %w", err)
+			return err
 		}
 
-		reqQuery = reqQuery.Where(sqlizer)
+		for _, reqLog := range reqLogs {
+			if err := ctx.Err(); err != nil {
+				return fmt.Errorf("sqlite: query aborted: %w", err)
+			}
+
+			if err := fn(reqLog); err != nil {
+				return err
+			}
+		}
+
+		if len(reqLogs) < maxIDsPerQuery {
+			return nil
+		}
+
+		pageQuery = reqQuery.Where(sq.Lt{"req.id": reqLogs[len(reqLogs)-1].ID}).Limit(maxIDsPerQuery)
 	}
+}
 
+// queryRequestLogs executes a request logs query, and then sets the headers of
+// the request logs in batches. Rows are read before headers are queried, so a
+// single database connection suffices.
+func (c *Client) queryRequestLogs(
+	ctx context.Context,
+	httpReqLogsQuery httpRequestLogsQuery,
+	reqQuery sq.SelectBuilder,
+) ([]reqlog.Request, error) {
 	sql, args, err := reqQuery.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("sqlite: could not parse query: %w", err)
 	}
 
-	rows, err := c.db.QueryxContext(ctx, sql, args...)
+	rows, err := c.conn.QueryxContext(ctx, sql, args...)
 	if err != nil {
 		return nil, fmt.Errorf("sqlite: could not execute query: %w", err)
 	}
 	defer rows.Close()
 
+	var reqLogs []reqlog.Request
+
 	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("sqlite: query aborted: %w", err)
+		}
+
 		var dto httpRequest
 
-		err = rows.StructScan(&dto)
-		if err != nil {
+		if err := rows.StructScan(&dto); err != nil {
 			return nil, fmt.Errorf("sqlite: could not scan row: %w", err)
 		}
 
@@ -296,13 +864,265 @@ func (c *Client) FindRequestLogs(
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("sqlite: could not iterate over rows: %w", err)
 	}
-	defer rows.Close()
 
-	if err := c.queryHeaders(ctx, httpReqLogsQuery, reqLogs); err != nil {
-		return nil, fmt.Errorf("sqlite: could not query headers: %w", err)
+	rows.Close()
+
+	for start := 0; start < len(reqLogs); start += maxIDsPerQuery {
+		end := start + maxIDsPerQuery
+		if end > len(reqLogs) {
+			end = len(reqLogs)
+		}
+
+		if err := c.queryHeadersBatch(ctx, httpReqLogsQuery, reqLogs[start:end]); err != nil {
+			return nil, fmt.Errorf("sqlite: could not query headers: %w", err)
+		}
+	}
+
+	return reqLogs, nil
+}
+
+// CountRequestLogs returns the number of request logs that match the filter.
+func (c *Client) CountRequestLogs(
+	ctx context.Context,
+	filter reqlog.FindRequestsFilter,
+	scope *scope.Scope,
+) (int64, error) {
+	if c.db == nil {
+		return 0, proj.ErrNoProject
+	}
+
+	reqQuery, err := findRequestLogsQuery(httpRequestLogsQuery{requestCols: []string{"COUNT(*)"}}, filter, scope)
+	if err != nil {
+		return 0, err
+	}
+
+	sql, args, err := reqQuery.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not parse query: %w", err)
+	}
+
+	var count int64
+
+	if err := c.conn.GetContext(ctx, &count, sql, args...); err != nil {
+		return 0, fmt.Errorf("sqlite: could not count request logs: %w", err)
+	}
+
+	return count, nil
+}
+
+func findRequestLogsQuery(
+	httpReqLogsQuery httpRequestLogsQuery,
+	filter reqlog.FindRequestsFilter,
+	scope *scope.Scope,
+) (sq.SelectBuilder, error) {
+	reqQuery := sq.
+		Select(httpReqLogsQuery.requestCols...).
+		From("http_requests req").
+		OrderBy("req.id DESC")
+	// Search expressions can match on response fields, so the response is
+	// joined even if none of its fields are selected.
+	joinResponse := httpReqLogsQuery.joinResponse ||
+		filter.SearchExpr != nil ||
+		filter.ResponseSizeMin != nil ||
+		filter.ResponseSizeMax != nil ||
+		len(filter.ResponseHeaders) > 0 ||
+		filter.CacheStatus != "" ||
+		len(filter.StatusClasses) > 0
+	if joinResponse {
+		reqQuery = reqQuery.LeftJoin("http_responses res ON req.id = res.req_id")
+	}
+
+	var inScopeExpr sq.Sqlizer
+	if scope != nil {
+		inScopeExpr = scopeExpr(scope.Rules())
+	}
+
+	if filter.OnlyInScope && inScopeExpr != nil {
+		reqQuery = reqQuery.Where(inScopeExpr)
+	}
+
+	// Without rules, no request log is in scope.
+	if filter.InScope != nil {
+		switch {
+		case inScopeExpr != nil && *filter.InScope:
+			reqQuery = reqQuery.Where(inScopeExpr)
+		case inScopeExpr != nil:
+			reqQuery = reqQuery.Where(sq.Expr("NOT (?)", inScopeExpr))
+		case *filter.InScope:
+			reqQuery = reqQuery.Where("FALSE")
+		}
+	}
+
+	if filter.PathPrefix != "" {
+		reqQuery = reqQuery.Where(likeExpr("url_path(req.url)", filter.CaseInsensitive),
+			escapeLike(filter.PathPrefix)+"%")
+	}
+
+	if filter.QueryContains != "" {
+		reqQuery = reqQuery.Where(likeExpr("url_query(req.url)", filter.CaseInsensitive),
+			"%"+escapeLike(filter.QueryContains)+"%")
+	}
+
+	if filter.ConnID != 0 {
+		reqQuery = reqQuery.Where(sq.Eq{"req.conn_id": filter.ConnID})
+	}
+
+	if filter.SNI != "" {
+		reqQuery = reqQuery.Where("req.sni = ? COLLATE NOCASE", filter.SNI)
+	}
+
+	if filter.Listener != "" {
+		reqQuery = reqQuery.Where(sq.Eq{"req.listener": filter.Listener})
+	}
+
+	// Responses stored before cache statuses were detected have none.
+	if filter.CacheStatus != "" {
+		reqQuery = reqQuery.Where("res.id IS NOT NULL AND IFNULL(res.cache_status, ?) = ?",
+			string(reqlog.CacheStatusUnknown), string(filter.CacheStatus))
+	}
+
+	if len(filter.StatusClasses) > 0 {
+		statusClassExpr := make(sq.Or, len(filter.StatusClasses))
+
+		for i, class := range filter.StatusClasses {
+			min, max, ok := class.Range()
+			if !ok {
+				return sq.SelectBuilder{}, fmt.Errorf("sqlite: unknown status class (%v)", class)
+			}
+
+			statusClassExpr[i] = sq.Expr("res.status_code BETWEEN ? AND ?", min, max)
+		}
+
+		reqQuery = reqQuery.Where(statusClassExpr)
+	}
+
+	if filter.Unread != nil {
+		if *filter.Unread {
+			reqQuery = reqQuery.Where(unreadExpr)
+		} else {
+			reqQuery = reqQuery.Where("NOT (" + unreadExpr + ")")
+		}
+	}
+
+	if filter.Starred != nil {
+		reqQuery = reqQuery.Where("req.starred = ?", *filter.Starred)
+	}
+
+	if len(filter.Protos) > 0 {
+		protos := make([]string, len(filter.Protos))
+		for i, proto := range filter.Protos {
+			protos[i] = normalizeProto(proto)
+		}
+
+		reqQuery = reqQuery.Where(sq.Eq{"req.proto": protos})
+	}
+
+	// Media types are case-insensitive, so content types always are, too.
+	if filter.RequestContentType != "" {
+		reqQuery = reqQuery.Where(`EXISTS (
+			SELECT 1 FROM http_headers h
+			WHERE h.req_id = req.id AND h.key = 'Content-Type' AND `+likeExpr("h.value", true)+`
+		)`, escapeLike(filter.RequestContentType)+"%")
+	}
+
+	if filter.ResponseContentType != "" {
+		reqQuery = reqQuery.Where(`EXISTS (
+			SELECT 1 FROM http_responses r
+			JOIN http_headers h ON h.res_id = r.id
+			WHERE r.req_id = req.id AND h.key = 'Content-Type' AND `+likeExpr("h.value", true)+`
+		)`, escapeLike(filter.ResponseContentType)+"%")
+	}
+
+	for _, match := range filter.RequestHeaders {
+		reqQuery = reqQuery.Where(headerMatchExpr("h.req_id = req.id", match, filter.CaseInsensitive))
+	}
+
+	for _, match := range filter.ResponseHeaders {
+		reqQuery = reqQuery.Where(headerMatchExpr("h.res_id = res.id", match, filter.CaseInsensitive))
+	}
+
+	if filter.ResponseSizeMin != nil || filter.ResponseSizeMax != nil {
+		reqQuery = reqQuery.Where("res.id IS NOT NULL")
+	}
+
+	if filter.ResponseSizeMin != nil {
+		reqQuery = reqQuery.Where("IFNULL(LENGTH(res.body), 0) >= ?", *filter.ResponseSizeMin)
+	}
+
+	if filter.ResponseSizeMax != nil {
+		reqQuery = reqQuery.Where("IFNULL(LENGTH(res.body), 0) <= ?", *filter.ResponseSizeMax)
+	}
+
+	if filter.JSONPathMatch != nil {
+		if _, err := parseJSONPath(filter.JSONPathMatch.Path); err != nil {
+			return sq.SelectBuilder{}, err
+		}
+
+		reqQuery = reqQuery.Where("json_path_match(req.body, ?, ?)", filter.JSONPathMatch.Path, filter.JSONPathMatch.Value)
+	}
+
+	if filter.SearchExpr != nil {
+		sqlizer, err := parseSearchExpr(filter.SearchExpr, filter.CaseInsensitive)
+		if err != nil {
+			return sq.SelectBuilder{}, fmt.Errorf("sqlite: could not parse search expression: %w", err)
+		}
+
+		reqQuery = reqQuery.Where(sqlizer)
+	}
+
+	return reqQuery, nil
+}
+
+// headerMatchExpr returns an `EXISTS` expression for a header that matches, for
+// headers that are joined with the owner (request or response) condition.
+// Header keys are always matched case-insensitively.
+func headerMatchExpr(ownerCond string, match reqlog.HeaderMatch, caseInsensitive bool) sq.Sqlizer {
+	cond := sq.And{
+		sq.Expr(ownerCond),
+		sq.Expr("LOWER(h.key) = LOWER(?)", match.Key),
+	}
+
+	if match.Value != "" {
+		cond = append(cond, sq.Expr(likeExpr("h.value", caseInsensitive), "%"+escapeLike(match.Value)+"%"))
+	}
+
+	return sq.Expr("EXISTS (SELECT 1 FROM http_headers h WHERE ?)", cond)
+}
+
+// normalizeProto returns a protocol version in the format it's stored in, so
+// e.g. "http/2" matches "HTTP/2.0".
+func normalizeProto(proto string) string {
+	proto = strings.ToUpper(strings.TrimSpace(proto))
+
+	if major, minor, ok := http.ParseHTTPVersion(proto); ok {
+		return fmt.Sprintf("HTTP/%d.%d", major, minor)
+	}
+
+	if strings.HasPrefix(proto, "HTTP/") && !strings.Contains(proto, ".") {
+		if major, _, ok := http.ParseHTTPVersion(proto + ".0"); ok {
+			return fmt.Sprintf("HTTP/%d.0", major)
+		}
+	}
+
+	return proto
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// likeExpr returns a `LIKE` expression with a single placeholder for the
+// (escaped) pattern.
+func likeExpr(column string, caseInsensitive bool) string {
+	if caseInsensitive {
+		return fmt.Sprintf(`LOWER(%v) LIKE LOWER(?) ESCAPE '\'`, column)
 	}
 
-	return reqLogs, nil
+	return fmt.Sprintf(`%v LIKE ? ESCAPE '\'`, column)
+}
+
+// escapeLike escapes wildcard characters, for use in a `LIKE` expression with
+// `ESCAPE '\'`.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
 }
 
 func (c *Client) FindRequestLogByID(ctx context.Context, id int64) (reqlog.Request, error) {
@@ -325,7 +1145,7 @@ func (c *Client) FindRequestLogByID(ctx context.Context, id int64) (reqlog.Reque
 		return reqlog.Request{}, fmt.Errorf("sqlite: could not parse query: %w", err)
 	}
 
-	row := c.db.QueryRowxContext(ctx, reqSQL, id)
+	row := c.conn.QueryRowxContext(ctx, reqSQL, id)
 
 	var dto httpRequest
 
@@ -346,121 +1166,416 @@ func (c *Client) FindRequestLogByID(ctx context.Context, id int64) (reqlog.Reque
 	return reqLogs[0], nil
 }
 
-func (c *Client) AddRequestLog(
-	ctx context.Context,
-	req http.Request,
-	body []byte,
-	timestamp time.Time,
-) (*reqlog.Request, error) {
+// AddRequestLog stores a request log. Besides the request, body and timestamp,
+// flags like BodyTruncated and, if the request was already sent, ServerAddr are
+// stored. It returns the stored request log, with its ID set.
+func (c *Client) AddRequestLog(ctx context.Context, reqLog reqlog.Request) (*reqlog.Request, error) {
+	if c.db == nil {
+		return nil, proj.ErrNoProject
+	}
+
+	reqLog.Request.URL = absoluteURL(reqLog.Request)
+	reqLog.Timestamp = reqLog.Timestamp.UTC()
+	reqLog.Response = nil
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	tx, err := c.beginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not start transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	if err := c.insertRequestLog(ctx, tx, &reqLog, ""); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("sqlite: could not commit transaction: %w", err)
+	}
+
+	return &reqLog, nil
+}
+
+// absoluteURL returns the fully qualified URL of a request. Requests received
+// by the proxy after a CONNECT (or sent in origin form) only have a path in
+// their URL, so the scheme and host are reconstructed from the TLS state and
+// the `Host` header. For CONNECT requests, which only have an authority, the
+// URL consists of the scheme and host. The request URL isn't mutated.
+func absoluteURL(req http.Request) *url.URL {
+	u := &url.URL{}
+	if req.URL != nil {
+		*u = *req.URL
+	}
+
+	if u.Host == "" {
+		u.Host = req.Host
+	}
+
+	if u.Scheme == "" {
+		u.Scheme = "http"
+		if req.TLS != nil || req.Method == http.MethodConnect {
+			u.Scheme = "https"
+		}
+	}
+
+	if req.Method == http.MethodConnect {
+		return &url.URL{Scheme: u.Scheme, Host: u.Host}
+	}
+
+	return u
+}
+
+// statusReason returns the reason phrase of a status line, e.g. "OK" for
+// "200 OK". It's empty if the status has no reason, or is empty or malformed.
+func statusReason(status string) string {
+	i := strings.IndexByte(status, ' ')
+	if i == -1 {
+		return ""
+	}
+
+	return strings.TrimSpace(status[i+1:])
+}
+
+// contentLengthMismatch reports whether the length of a response body differs
+// from its `Content-Length` header. Responses without the header (e.g. chunked
+// responses) and responses that have no body by definition are never a
+// mismatch. Neither are gzip encoded responses, because their bodies are
+// decoded before they're stored.
+func contentLengthMismatch(res http.Response, body []byte) bool {
+	rawContentLength := res.Header.Get("Content-Length")
+	if rawContentLength == "" {
+		return false
+	}
+
+	if res.Request != nil && res.Request.Method == http.MethodHead {
+		return false
+	}
+
+	if res.StatusCode == http.StatusNoContent || res.StatusCode == http.StatusNotModified {
+		return false
+	}
+
+	if strings.EqualFold(res.Header.Get("Content-Encoding"), "gzip") {
+		return false
+	}
+
+	contentLength, err := strconv.ParseInt(strings.TrimSpace(rawContentLength), 10, 64)
+	if err != nil {
+		// An invalid header can't be satisfied by any body.
+		return true
+	}
+
+	return contentLength != int64(len(body))
+}
+
+// skipBody returns true if the body of a response with the given content type
+// shouldn't be stored.
+func (c *Client) skipBody(contentType string) bool {
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	if contentType == "" {
+		return false
+	}
+
+	for _, prefix := range c.config.SkipBodyContentTypes {
+		if prefix != "" && strings.HasPrefix(contentType, strings.ToLower(prefix)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AddResponseLog stores reqLog.Response as the response log of the request log
+// with reqLog.ID. Besides the response, body and timestamp, flags like
+// BodyTruncated and BodySkipped are stored. Fields of the request log that are
+// only known once the request was sent, like ServerAddr and ClientCert, are
+// stored in the same transaction. It returns the stored response log, with its ID set.
+func (c *Client) AddResponseLog(ctx context.Context, reqLog reqlog.Request) (*reqlog.Response, error) {
 	if c.db == nil {
 		return nil, proj.ErrNoProject
 	}
 
-	reqLog := &reqlog.Request{
-		Request:   req,
-		Body:      body,
-		Timestamp: timestamp,
+	if reqLog.Response == nil {
+		return nil, errors.New("sqlite: request log has no response log")
 	}
 
-	tx, err := c.db.BeginTxx(ctx, nil)
+	resLog := *reqLog.Response
+	resLog.RequestID = reqLog.ID
+	resLog.Timestamp = resLog.Timestamp.UTC()
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	tx, err := c.beginTx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("sqlite: could not start transaction: %w", err)
 	}
+	defer tx.Rollback()
+
+	if err := c.insertResponseLog(ctx, tx, &resLog); err != nil {
+		return nil, err
+	}
+
+	if reqLog.ServerAddr != "" {
+		_, err := tx.ExecContext(ctx, `UPDATE http_requests SET server_addr = ? WHERE id = ?`, reqLog.ServerAddr, reqLog.ID)
+		if err != nil {
+			return nil, fmt.Errorf("sqlite: could not update server address: %w", err)
+		}
+	}
+
+	if reqLog.ClientCert {
+		_, err := tx.ExecContext(ctx, `UPDATE http_requests SET client_cert = TRUE WHERE id = ?`, reqLog.ID)
+		if err != nil {
+			return nil, fmt.Errorf("sqlite: could not flag client certificate: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("sqlite: could not commit transaction: %w", err)
+	}
+
+	return &resLog, nil
+}
+
+// AddRequestResponse stores a request log and its response log (reqLog.Response)
+// in a single transaction, so either both or neither are stored. It's meant
+// for imports and replays, where the response is known upfront. Both logs get
+// the timestamp of the request log.
+func (c *Client) AddRequestResponse(ctx context.Context, reqLog reqlog.Request) (*reqlog.Request, error) {
+	if c.db == nil {
+		return nil, proj.ErrNoProject
+	}
+
+	if reqLog.Response == nil {
+		return nil, errors.New("sqlite: request log has no response log")
+	}
+
+	resLog := *reqLog.Response
+
+	reqLog.Request.URL = absoluteURL(reqLog.Request)
+	reqLog.Timestamp = reqLog.Timestamp.UTC()
+	reqLog.Response = nil
 
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	tx, err := c.beginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not start transaction: %w", err)
+	}
 	defer tx.Rollback()
 
+	if err := c.insertRequestLog(ctx, tx, &reqLog, ""); err != nil {
+		return nil, err
+	}
+
+	resLog.RequestID = reqLog.ID
+	resLog.Timestamp = reqLog.Timestamp
+
+	if err := c.insertResponseLog(ctx, tx, &resLog); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("sqlite: could not commit transaction: %w", err)
+	}
+
+	reqLog.Response = &resLog
+
+	return &reqLog, nil
+}
+
+// errIdempotencyConflict is returned by insertRequestLog if a request log with
+// the same idempotency key exists.
+var errIdempotencyConflict = errors.New("sqlite: request log with idempotency key exists")
+
+// insertRequestLog inserts a request log and its headers, and sets its ID. If
+// idempotencyKey isn't empty, and a request log with the same key exists,
+// nothing is inserted and errIdempotencyConflict is returned.
+func (c *Client) insertRequestLog(ctx context.Context, tx dbConn, reqLog *reqlog.Request, idempotencyKey string) error {
+	// IDs are assigned explicitly, rather than by SQLite, which reuses the ID
+	// of the last request log if it was deleted.
 	reqStmt, err := tx.PrepareContext(ctx, `INSERT INTO http_requests (
+		id,
 		proto,
 		url,
 		method,
 		body,
-		timestamp
-	) VALUES (?, ?, ?, ?, ?)`)
+		timestamp,
+		tls_version,
+		tls_cipher,
+		fingerprint,
+		host_header,
+		referer,
+		canonical_url,
+		idempotency_key,
+		conn_id,
+		grpc_method,
+		sni,
+		body_hash,
+		listener,
+		body_truncated,
+		server_addr,
+		redirected_from,
+		client_cert
+	) VALUES (
+		MAX(
+			IFNULL((SELECT MAX(id) FROM http_requests), 0),
+			IFNULL((SELECT seq FROM http_request_seq), 0)
+		) + 1,
+		?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+	)
+	ON CONFLICT (idempotency_key) DO NOTHING`)
 	if err != nil {
-		return nil, fmt.Errorf("sqlite: could not prepare statement: %w", err)
+		return fmt.Errorf("sqlite: could not prepare statement: %w", err)
 	}
 	defer reqStmt.Close()
 
+	var tlsVersion, tlsCipher sql.NullInt64
+	if reqLog.Request.TLS != nil {
+		tlsVersion = sql.NullInt64{Int64: int64(reqLog.Request.TLS.Version), Valid: true}
+		tlsCipher = sql.NullInt64{Int64: int64(reqLog.Request.TLS.CipherSuite), Valid: true}
+		reqLog.SNI = reqLog.Request.TLS.ServerName
+	}
+
+	var canonicalURL sql.NullString
+
+	fingerprintURL := reqLog.Request.URL.String()
+	if c.config.NormalizeURLs {
+		reqLog.CanonicalURL = normalizeURL(reqLog.Request.URL, c.config.StripQueryParams)
+		canonicalURL = sql.NullString{String: reqLog.CanonicalURL, Valid: true}
+		fingerprintURL = reqLog.CanonicalURL
+	}
+
+	reqLog.Fingerprint = fingerprint(reqLog.Request.Method, fingerprintURL, reqLog.Body)
+	reqLog.BodyHash = bodyHash(reqLog.Body)
+	reqLog.Referer = reqLog.Request.Header.Get("Referer")
+	reqLog.GRPCMethod = reqlog.GRPCMethod(reqLog.Request)
+
+	var referer sql.NullString
+	if reqLog.Referer != "" {
+		referer = sql.NullString{String: reqLog.Referer, Valid: true}
+	}
+
 	result, err := reqStmt.ExecContext(ctx,
 		reqLog.Request.Proto,
 		reqLog.Request.URL.String(),
 		reqLog.Request.Method,
 		reqLog.Body,
-		reqLog.Timestamp,
+		formatTimestamp(reqLog.Timestamp),
+		tlsVersion,
+		tlsCipher,
+		reqLog.Fingerprint,
+		reqLog.Request.Host,
+		referer,
+		canonicalURL,
+		sql.NullString{String: idempotencyKey, Valid: idempotencyKey != ""},
+		sql.NullInt64{Int64: reqLog.ConnID, Valid: reqLog.ConnID != 0},
+		sql.NullString{String: reqLog.GRPCMethod, Valid: reqLog.GRPCMethod != ""},
+		sql.NullString{String: reqLog.SNI, Valid: reqLog.SNI != ""},
+		sql.NullString{String: reqLog.BodyHash, Valid: reqLog.BodyHash != ""},
+		sql.NullString{String: reqLog.Listener, Valid: reqLog.Listener != ""},
+		reqLog.BodyTruncated,
+		sql.NullString{String: reqLog.ServerAddr, Valid: reqLog.ServerAddr != ""},
+		sql.NullInt64{Int64: reqLog.RedirectedFrom, Valid: reqLog.RedirectedFrom != 0},
+		reqLog.ClientCert,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("sqlite: could not execute statement: %w", err)
+		return fmt.Errorf("sqlite: could not execute statement: %w", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("sqlite: could not get affected rows: %w", err)
+	}
+
+	if n == 0 {
+		return errIdempotencyConflict
 	}
 
 	reqID, err := result.LastInsertId()
 	if err != nil {
-		return nil, fmt.Errorf("sqlite: could not get last insert ID: %w", err)
+		return fmt.Errorf("sqlite: could not get last insert ID: %w", err)
 	}
 
 	reqLog.ID = reqID
 
+	_, err = tx.ExecContext(ctx, `INSERT INTO http_request_seq (id, seq) VALUES (1, ?)
+		ON CONFLICT (id) DO UPDATE SET seq = excluded.seq`, reqID)
+	if err != nil {
+		return fmt.Errorf("sqlite: could not update request log sequence: %w", err)
+	}
+
 	headerStmt, err := tx.PrepareContext(ctx, `INSERT INTO http_headers (
 		req_id,
 		key,
 		value
 	) VALUES (?, ?, ?)`)
 	if err != nil {
-		return nil, fmt.Errorf("sqlite: could not prepare statement: %w", err)
+		return fmt.Errorf("sqlite: could not prepare statement: %w", err)
 	}
 	defer headerStmt.Close()
 
-	err = insertHeaders(ctx, headerStmt, reqID, reqLog.Request.Header)
+	truncated, err := c.insertHeaders(ctx, headerStmt, reqID, reqLog.Request.Header)
 	if err != nil {
-		return nil, fmt.Errorf("sqlite: could not insert http headers: %w", err)
-	}
-
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("sqlite: could not commit transaction: %w", err)
+		return fmt.Errorf("sqlite: could not insert http headers: %w", err)
 	}
 
-	return reqLog, nil
-}
-
-func (c *Client) AddResponseLog(
-	ctx context.Context,
-	reqID int64,
-	res http.Response,
-	body []byte,
-	timestamp time.Time,
-) (*reqlog.Response, error) {
-	if c.db == nil {
-		return nil, proj.ErrNoProject
-	}
+	if truncated {
+		reqLog.HeadersTruncated = true
 
-	resLog := &reqlog.Response{
-		RequestID: reqID,
-		Response:  res,
-		Body:      body,
-		Timestamp: timestamp,
+		_, err := tx.ExecContext(ctx, `UPDATE http_requests SET headers_truncated = TRUE WHERE id = ?`, reqID)
+		if err != nil {
+			return fmt.Errorf("sqlite: could not flag truncated headers: %w", err)
+		}
 	}
 
-	tx, err := c.db.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, fmt.Errorf("sqlite: could not start transaction: %w", err)
-	}
-	defer tx.Rollback()
+	return nil
+}
 
+// insertResponseLog inserts a response log and its headers, and sets its ID.
+// The body is dropped if its content type is configured to be skipped.
+func (c *Client) insertResponseLog(ctx context.Context, tx dbConn, resLog *reqlog.Response) error {
 	resStmt, err := tx.PrepareContext(ctx, `INSERT INTO http_responses (
 		req_id,
 		proto,
 		status_code,
 		status_reason,
 		body,
-		timestamp
-	) VALUES (?, ?, ?, ?, ?, ?)`)
+		timestamp,
+		content_length_mismatch,
+		body_skipped,
+		cache_status,
+		body_hash,
+		body_truncated,
+		timings
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
 	if err != nil {
-		return nil, fmt.Errorf("sqlite: could not prepare statement: %w", err)
+		return fmt.Errorf("sqlite: could not prepare statement: %w", err)
 	}
 	defer resStmt.Close()
 
-	var statusReason string
-	if len(resLog.Response.Status) > 4 {
-		statusReason = resLog.Response.Status[4:]
+	statusReason := statusReason(resLog.Response.Status)
+
+	// A truncated body is shorter than its `Content-Length` by definition, and
+	// the length of a body that wasn't captured is unknown.
+	resLog.ContentLengthMismatch = !resLog.BodyTruncated && !resLog.BodySkipped &&
+		contentLengthMismatch(resLog.Response, resLog.Body)
+	resLog.CacheStatus = reqlog.ParseCacheStatus(resLog.Response.Header)
+
+	if c.skipBody(resLog.Response.Header.Get("Content-Type")) {
+		resLog.Body = nil
+		resLog.BodySkipped = true
+	}
+
+	resLog.BodyHash = bodyHash(resLog.Body)
+
+	timings, err := encodeTimings(resLog.Timings)
+	if err != nil {
+		return err
 	}
 
 	result, err := resStmt.ExecContext(ctx,
@@ -469,15 +1584,21 @@ func (c *Client) AddResponseLog(
 		resLog.Response.StatusCode,
 		statusReason,
 		resLog.Body,
-		resLog.Timestamp,
+		formatTimestamp(resLog.Timestamp),
+		resLog.ContentLengthMismatch,
+		resLog.BodySkipped,
+		string(resLog.CacheStatus),
+		sql.NullString{String: resLog.BodyHash, Valid: resLog.BodyHash != ""},
+		resLog.BodyTruncated,
+		timings,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("sqlite: could not execute statement: %w", err)
+		return fmt.Errorf("sqlite: could not execute statement: %w", err)
 	}
 
 	resID, err := result.LastInsertId()
 	if err != nil {
-		return nil, fmt.Errorf("sqlite: could not get last insert ID: %w", err)
+		return fmt.Errorf("sqlite: could not get last insert ID: %w", err)
 	}
 
 	resLog.ID = resID
@@ -488,20 +1609,25 @@ func (c *Client) AddResponseLog(
 		value
 	) VALUES (?, ?, ?)`)
 	if err != nil {
-		return nil, fmt.Errorf("sqlite: could not prepare statement: %w", err)
+		return fmt.Errorf("sqlite: could not prepare statement: %w", err)
 	}
 	defer headerStmt.Close()
 
-	err = insertHeaders(ctx, headerStmt, resID, resLog.Response.Header)
+	truncated, err := c.insertHeaders(ctx, headerStmt, resID, resLog.Response.Header)
 	if err != nil {
-		return nil, fmt.Errorf("sqlite: could not insert http headers: %w", err)
+		return fmt.Errorf("sqlite: could not insert http headers: %w", err)
 	}
 
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("sqlite: could not commit transaction: %w", err)
+	if truncated {
+		resLog.HeadersTruncated = true
+
+		_, err := tx.ExecContext(ctx, `UPDATE http_responses SET headers_truncated = TRUE WHERE id = ?`, resID)
+		if err != nil {
+			return fmt.Errorf("sqlite: could not flag truncated headers: %w", err)
+		}
 	}
 
-	return resLog, nil
+	return nil
 }
 
 func (c *Client) UpsertSettings(ctx context.Context, module string, settings interface{}) error {
@@ -515,9 +1641,15 @@ func (c *Client) UpsertSettings(ctx context.Context, module string, settings int
 		return fmt.Errorf("sqlite: could not encode settings as JSON: %w", err)
 	}
 
-	_, err = c.db.ExecContext(ctx,
-		`INSERT INTO settings (module, settings) VALUES (?, ?)
-		ON CONFLICT(module) DO UPDATE SET settings = ?`, module, jsonSettings, jsonSettings)
+	now := formatTimestamp(time.Now())
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	_, err = c.conn.ExecContext(ctx,
+		`INSERT INTO settings (module, settings, created_at, updated_at) VALUES (?, ?, ?, ?)
+		ON CONFLICT(module) DO UPDATE SET settings = excluded.settings, updated_at = excluded.updated_at`,
+		module, jsonSettings, now, now)
 	if err != nil {
 		return fmt.Errorf("sqlite: could not insert scope settings: %w", err)
 	}
@@ -532,7 +1664,7 @@ func (c *Client) FindSettingsByModule(ctx context.Context, module string, settin
 
 	var jsonSettings []byte
 
-	row := c.db.QueryRowContext(ctx, `SELECT settings FROM settings WHERE module = ?`, module)
+	row := c.conn.QueryRowContext(ctx, `SELECT settings FROM settings WHERE module = ?`, module)
 
 	err := row.Scan(&jsonSettings)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -548,16 +1680,102 @@ func (c *Client) FindSettingsByModule(ctx context.Context, module string, settin
 	return nil
 }
 
-func insertHeaders(ctx context.Context, stmt *sql.Stmt, id int64, headers http.Header) error {
-	for key, values := range headers {
-		for _, value := range values {
+// FindSettingsTimestamps returns when the settings of a module were first
+// stored, and when they were last updated. Timestamps are zero for settings
+// stored before they were tracked.
+func (c *Client) FindSettingsTimestamps(ctx context.Context, module string) (createdAt, updatedAt time.Time, err error) {
+	if c.db == nil {
+		return time.Time{}, time.Time{}, proj.ErrNoProject
+	}
+
+	var created, updated sql.NullTime
+
+	row := c.conn.QueryRowContext(ctx, `SELECT created_at, updated_at FROM settings WHERE module = ?`, module)
+
+	err = row.Scan(&created, &updated)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, time.Time{}, proj.ErrNoSettings
+	} else if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("sqlite: could not scan row: %w", err)
+	}
+
+	return nullTimeToUTC(created), nullTimeToUTC(updated), nil
+}
+
+func nullTimeToUTC(t sql.NullTime) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+
+	return t.Time.UTC()
+}
+
+// insertHeaders inserts header lines, within the configured limits. It returns
+// true if header lines were dropped or values were truncated. Keys are inserted
+// in sorted order, so the same header lines are dropped for identical headers.
+func (c *Client) insertHeaders(ctx context.Context, stmt *sql.Stmt, id int64, headers http.Header) (bool, error) {
+	var (
+		count     int
+		truncated bool
+	)
+
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		values := headers[key]
+
+		for i, value := range values {
+			if c.config.CollapseDuplicateHeaders && containsString(values[:i], value) {
+				continue
+			}
+
+			if count >= c.config.MaxHeaders {
+				return true, nil
+			}
+
+			if len(value) > c.config.MaxHeaderValueLength {
+				value = truncateUTF8(value, c.config.MaxHeaderValueLength)
+				truncated = true
+			}
+
 			if _, err := stmt.ExecContext(ctx, id, key, value); err != nil {
-				return fmt.Errorf("could not execute statement: %w", err)
+				return false, fmt.Errorf("could not execute statement: %w", err)
 			}
+
+			count++
 		}
 	}
 
-	return nil
+	return truncated, nil
+}
+
+// truncateUTF8 cuts s to at most n bytes, without splitting a multi-byte
+// UTF-8 encoded character.
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+
+	return s[:n]
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
 }
 
 func findHeaders(ctx context.Context, stmt *sql.Stmt, id int64) (http.Header, error) {
@@ -588,21 +1806,60 @@ func findHeaders(ctx context.Context, stmt *sql.Stmt, id int64) (http.Header, er
 }
 
 func parseHTTPRequestLogsQuery(ctx context.Context) httpRequestLogsQuery {
+	// Outside of a GraphQL operation (e.g. exports), all fields are queried.
+	if !graphql.HasOperationContext(ctx) || graphql.GetFieldContext(ctx) == nil || reqlog.AllFields(ctx) {
+		return allHTTPRequestLogsQuery()
+	}
+
 	var (
 		joinResponse                 bool
+		reqBodySize, resBodySize     bool
 		reqHeaderCols, resHeaderCols []string
 	)
 
 	opCtx := graphql.GetOperationContext(ctx)
 	reqFields := graphql.CollectFieldsCtx(ctx, nil)
-	reqCols := []string{"req.id AS req_id", "res.id AS res_id"}
+	reqCols := []string{"req.id AS req_id"}
 
 	for _, reqField := range reqFields {
 		if col, ok := reqFieldToColumnMap[reqField.Name]; ok {
 			reqCols = append(reqCols, "req."+col)
 		}
 
-		if reqField.Name == "headers" {
+		// Hex dumps, search matches and body types are computed from the body.
+		if reqField.Name == "bodyHex" || reqField.Name == "matches" || reqField.Name == "bodyType" {
+			reqCols = append(reqCols, "req."+reqFieldToColumnMap["body"])
+		}
+
+		// Body presentation depends on the `Content-Encoding` header.
+		if reqField.Name == "body" || reqField.Name == "bodyDecoded" || reqField.Name == "bodyHex" ||
+			reqField.Name == "matches" || reqField.Name == "bodyType" {
+			reqHeaderCols = bodyHeaderCols
+		}
+
+		// Body types can be configured by URL path extension.
+		if reqField.Name == "bodyType" {
+			reqCols = append(reqCols, "req."+reqFieldToColumnMap["url"])
+		}
+
+		if reqField.Name == "headersConnection" {
+			reqHeaderCols = bodyHeaderCols
+		}
+
+		if reqField.Name == "bodyProtobuf" {
+			reqCols = append(reqCols, "req."+reqFieldToColumnMap["grpcMethod"])
+		}
+
+		// Snippets are generated from the complete request.
+		if reqField.Name == "asPython" {
+			for _, field := range []string{"method", "url", "hostHeader", "body"} {
+				reqCols = append(reqCols, "req."+reqFieldToColumnMap[field])
+			}
+
+			reqHeaderCols = bodyHeaderCols
+		}
+
+		if reqField.Name == "headers" && len(reqHeaderCols) == 0 {
 			headerFields := graphql.CollectFields(opCtx, reqField.Selections, nil)
 			for _, headerField := range headerFields {
 				if col, ok := headerFieldToColumnMap[headerField.Name]; ok {
@@ -611,13 +1868,52 @@ func parseHTTPRequestLogsQuery(ctx context.Context) httpRequestLogsQuery {
 			}
 		}
 
+		switch reqField.Name {
+		case "unread":
+			reqCols = append(reqCols, unreadCol)
+		case "bodyLineCount":
+			reqCols = append(reqCols, reqBodyLineCountCol)
+		case "requestBodySize":
+			reqBodySize = true
+		case "responseBodySize":
+			resBodySize = true
+		case "transferSize":
+			reqBodySize = true
+			resBodySize = true
+		}
+
 		if reqField.Name == "response" {
 			joinResponse = true
 			resFields := graphql.CollectFields(opCtx, reqField.Selections, nil)
 
 			for _, resField := range resFields {
-				if resField.Name == "headers" {
-					reqCols = append(reqCols, "res.id AS res_id")
+				if resField.Name == "body" || resField.Name == "bodyDecoded" || resField.Name == "bodyHex" ||
+					resField.Name == "matches" || resField.Name == "bodyType" {
+					resHeaderCols = bodyHeaderCols
+				}
+
+				if resField.Name == "bodyHex" || resField.Name == "matches" || resField.Name == "bodyType" {
+					reqCols = append(reqCols, "res."+resFieldToColumnMap["body"])
+				}
+
+				if resField.Name == "bodyType" {
+					reqCols = append(reqCols, "req."+reqFieldToColumnMap["url"])
+				}
+
+				if resField.Name == "headersConnection" {
+					resHeaderCols = bodyHeaderCols
+				}
+
+				if resField.Name == "bodyLineCount" {
+					reqCols = append(reqCols, resBodyLineCountCol)
+				}
+
+				// Response bodies are protobuf if the request is a gRPC request.
+				if resField.Name == "bodyProtobuf" {
+					reqCols = append(reqCols, "req."+reqFieldToColumnMap["grpcMethod"])
+				}
+
+				if resField.Name == "headers" && len(resHeaderCols) == 0 {
 					headerFields := graphql.CollectFields(opCtx, resField.Selections, nil)
 
 					for _, headerField := range headerFields {
@@ -634,6 +1930,20 @@ func parseHTTPRequestLogsQuery(ctx context.Context) httpRequestLogsQuery {
 		}
 	}
 
+	if reqBodySize {
+		reqCols = append(reqCols, reqBodySizeCol)
+	}
+
+	if resBodySize {
+		joinResponse = true
+		reqCols = append(reqCols, resBodySizeCol)
+	}
+
+	// The response ID can only be selected when the response is joined.
+	if joinResponse {
+		reqCols = append(reqCols, "res.id AS res_id")
+	}
+
 	return httpRequestLogsQuery{
 		requestCols:        reqCols,
 		requestHeaderCols:  reqHeaderCols,
@@ -642,6 +1952,29 @@ func parseHTTPRequestLogsQuery(ctx context.Context) httpRequestLogsQuery {
 	}
 }
 
+func allHTTPRequestLogsQuery() httpRequestLogsQuery {
+	reqCols := []string{
+		"req.id AS req_id", "res.id AS res_id", reqBodySizeCol, resBodySizeCol, unreadCol,
+	}
+
+	for _, col := range reqFieldToColumnMap {
+		reqCols = append(reqCols, "req."+col)
+	}
+
+	for _, col := range resFieldToColumnMap {
+		reqCols = append(reqCols, "res."+col)
+	}
+
+	headerCols := []string{headerFieldToColumnMap["key"], headerFieldToColumnMap["value"]}
+
+	return httpRequestLogsQuery{
+		requestCols:        reqCols,
+		requestHeaderCols:  headerCols,
+		responseHeaderCols: headerCols,
+		joinResponse:       true,
+	}
+}
+
 func (c *Client) queryHeaders(
 	ctx context.Context,
 	query httpRequestLogsQuery,
@@ -651,21 +1984,35 @@ func (c *Client) queryHeaders(
 		reqHeadersQuery, _, err := sq.
 			Select(query.requestHeaderCols...).
 			From("http_headers").Where("req_id = ?").
+			OrderBy("id").
 			ToSql()
 		if err != nil {
 			return fmt.Errorf("could not parse request headers query: %w", err)
 		}
 
-		reqHeadersStmt, err := c.db.PrepareContext(ctx, reqHeadersQuery)
+		reqHeadersStmt, err := c.conn.PrepareContext(ctx, reqHeadersQuery)
 		if err != nil {
 			return fmt.Errorf("could not prepare statement: %w", err)
 		}
 		defer reqHeadersStmt.Close()
 
 		for i := range reqLogs {
+			if err := ctx.Err(); err != nil {
+				return fmt.Errorf("query aborted: %w", err)
+			}
+
+			// A request log with headers that can't be read (e.g. because of
+			// corrupt rows) gets an error, rather than failing all request logs.
 			headers, err := findHeaders(ctx, reqHeadersStmt, reqLogs[i].ID)
 			if err != nil {
-				return fmt.Errorf("could not query request headers: %w", err)
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					return fmt.Errorf("query aborted: %w", ctxErr)
+				}
+
+				reqLogs[i].Request.Header = make(http.Header)
+				reqLogs[i].HeadersErr = fmt.Errorf("could not query request headers: %w", err)
+
+				continue
 			}
 
 			reqLogs[i].Request.Header = headers
@@ -676,25 +2023,37 @@ func (c *Client) queryHeaders(
 		resHeadersQuery, _, err := sq.
 			Select(query.responseHeaderCols...).
 			From("http_headers").Where("res_id = ?").
+			OrderBy("id").
 			ToSql()
 		if err != nil {
 			return fmt.Errorf("could not parse response headers query: %w", err)
 		}
 
-		resHeadersStmt, err := c.db.PrepareContext(ctx, resHeadersQuery)
+		resHeadersStmt, err := c.conn.PrepareContext(ctx, resHeadersQuery)
 		if err != nil {
 			return fmt.Errorf("could not prepare statement: %w", err)
 		}
 		defer resHeadersStmt.Close()
 
 		for i := range reqLogs {
+			if err := ctx.Err(); err != nil {
+				return fmt.Errorf("query aborted: %w", err)
+			}
+
 			if reqLogs[i].Response == nil {
 				continue
 			}
 
 			headers, err := findHeaders(ctx, resHeadersStmt, reqLogs[i].Response.ID)
 			if err != nil {
-				return fmt.Errorf("could not query response headers: %w", err)
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					return fmt.Errorf("query aborted: %w", ctxErr)
+				}
+
+				reqLogs[i].Response.Response.Header = make(http.Header)
+				reqLogs[i].Response.HeadersErr = fmt.Errorf("could not query response headers: %w", err)
+
+				continue
 			}
 
 			reqLogs[i].Response.Response.Header = headers
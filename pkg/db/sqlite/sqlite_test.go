@@ -0,0 +1,2997 @@
+package sqlite
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+func newTestClient(t *testing.T) *Client {
+	t.Helper()
+
+	client, err := New(Config{ProjectsPath: t.TempDir()})
+	if err != nil {
+		t.Fatalf("could not create client: %v", err)
+	}
+
+	if err := client.OpenProject("test"); err != nil {
+		t.Fatalf("could not open project: %v", err)
+	}
+
+	t.Cleanup(func() { client.Close() })
+
+	return client
+}
+
+func addTestRequestLogs(t *testing.T, client *Client, n int) {
+	t.Helper()
+
+	for i := 0; i < n; i++ {
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+		req.Header.Set("X-Foo", "bar")
+
+		addTestRequestLog(t, client, reqlog.Request{Request: *req})
+	}
+}
+
+// addTestRequestLog stores reqLog, and reqLog.Response as its response log if
+// it's set, and returns the stored request log. Zero timestamps are set to the
+// current time.
+func addTestRequestLog(t *testing.T, client *Client, reqLog reqlog.Request) *reqlog.Request {
+	t.Helper()
+
+	ctx := context.Background()
+
+	resLog := reqLog.Response
+	reqLog.Response = nil
+
+	if reqLog.Timestamp.IsZero() {
+		reqLog.Timestamp = time.Now()
+	}
+
+	stored, err := client.AddRequestLog(ctx, reqLog)
+	if err != nil {
+		t.Fatalf("could not add request log: %v", err)
+	}
+
+	if resLog == nil {
+		return stored
+	}
+
+	if resLog.Timestamp.IsZero() {
+		resLog.Timestamp = time.Now()
+	}
+
+	stored.Response = resLog
+
+	stored.Response, err = client.AddResponseLog(ctx, *stored)
+	if err != nil {
+		t.Fatalf("could not add response log: %v", err)
+	}
+
+	return stored
+}
+
+func TestFindRequestLogsCancelledContext(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	addTestRequestLogs(t, client, 10)
+
+	t.Run("cancelled before query", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := client.FindRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled error, got: %v", err)
+		}
+	})
+
+	t.Run("cancelled while iterating", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var calls int
+
+		err := client.StreamRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil, func(_ reqlog.Request) error {
+			calls++
+			cancel()
+
+			return nil
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled error, got: %v", err)
+		}
+
+		if calls != 1 {
+			t.Errorf("expected callback to be called once, got: %v", calls)
+		}
+	})
+}
+
+func TestStreamRequestLogs(t *testing.T) {
+	t.Parallel()
+
+	const n = 10
+
+	client := newTestClient(t)
+	addTestRequestLogs(t, client, n)
+
+	ctx := context.Background()
+
+	t.Run("calls callback for every row", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int
+
+		err := client.StreamRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil, func(_ reqlog.Request) error {
+			calls++
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if calls != n {
+			t.Errorf("expected callback to be called %v times, got: %v", n, calls)
+		}
+	})
+
+	t.Run("stops on callback error", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int
+
+		errStop := errors.New("stop")
+
+		err := client.StreamRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil, func(_ reqlog.Request) error {
+			calls++
+			if calls == 3 {
+				return errStop
+			}
+
+			return nil
+		})
+		if !errors.Is(err, errStop) {
+			t.Fatalf("expected error %v, got: %v", errStop, err)
+		}
+
+		if calls != 3 {
+			t.Errorf("expected callback to be called 3 times, got: %v", calls)
+		}
+	})
+}
+
+func TestFindRequestLogsSingleConn(t *testing.T) {
+	t.Parallel()
+
+	client, err := New(Config{ProjectsPath: t.TempDir(), MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("could not create client: %v", err)
+	}
+
+	if err := client.OpenProject("test"); err != nil {
+		t.Fatalf("could not open project: %v", err)
+	}
+
+	t.Cleanup(func() { client.Close() })
+
+	// More than a single page of streamed rows.
+	n := maxIDsPerQuery + 1
+	addTestRequestLogs(t, client, n)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	reqLogs, err := client.FindRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reqLogs) != n {
+		t.Fatalf("expected %v request logs, got: %v", n, len(reqLogs))
+	}
+
+	if got := reqLogs[n-1].Request.Header.Get("X-Foo"); got != "bar" {
+		t.Errorf("expected header `X-Foo` to be %q, got: %q", "bar", got)
+	}
+
+	var streamed int
+
+	err = client.StreamRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil, func(reqLog reqlog.Request) error {
+		if reqLog.ID != reqLogs[streamed].ID {
+			return fmt.Errorf("expected request log %v, got: %v", reqLogs[streamed].ID, reqLog.ID)
+		}
+
+		streamed++
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if streamed != n {
+		t.Errorf("expected %v streamed request logs, got: %v", n, streamed)
+	}
+}
+
+func TestConcurrentReadsAndWrites(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	const workers = 8
+
+	errs := make(chan error, workers*2)
+	wg := sync.WaitGroup{}
+
+	for i := 0; i < workers; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 25; j++ {
+				req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+				req.Header.Set("X-Foo", "bar")
+
+				reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Body: []byte("foo"), Timestamp: time.Now()})
+				if err != nil {
+					errs <- err
+					return
+				}
+
+				res := http.Response{Status: "200 OK", StatusCode: 200, Header: http.Header{"X-Bar": []string{"baz"}}}
+
+				if _, err := client.AddResponseLog(ctx, reqlog.Request{
+					ID:       reqLog.ID,
+					Response: &reqlog.Response{Response: res, Body: []byte("bar"), Timestamp: time.Now()},
+				}); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 25; j++ {
+				if _, err := client.FindRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	reqLogs, err := client.FindRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if exp := workers * 25; len(reqLogs) != exp {
+		t.Errorf("expected %v request logs, got: %v", exp, len(reqLogs))
+	}
+}
+
+func TestFindRequestLogsURLFilters(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	for _, rawURL := range []string{
+		"https://example.com/admin/users?id=1",
+		"https://admin.example.com/",
+		"https://example.com/foo?next=/admin",
+		"https://example.com/foo?uid=2",
+		"https://example.com/a_b",
+	} {
+		req := httptest.NewRequest(http.MethodGet, rawURL, nil)
+		if _, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()}); err != nil {
+			t.Fatalf("could not add request log: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		filter reqlog.FindRequestsFilter
+		exp    []string
+	}{
+		{
+			name:   "path prefix is anchored at path start",
+			filter: reqlog.FindRequestsFilter{PathPrefix: "/admin"},
+			exp:    []string{"https://example.com/admin/users?id=1"},
+		},
+		{
+			name:   "path prefix treats wildcards literally",
+			filter: reqlog.FindRequestsFilter{PathPrefix: "/a_"},
+			exp:    []string{"https://example.com/a_b"},
+		},
+		{
+			name:   "query contains",
+			filter: reqlog.FindRequestsFilter{QueryContains: "id="},
+			exp:    []string{"https://example.com/foo?uid=2", "https://example.com/admin/users?id=1"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reqLogs, err := client.FindRequestLogs(ctx, tt.filter, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := make([]string, len(reqLogs))
+			for i, reqLog := range reqLogs {
+				got[i] = reqLog.Request.URL.String()
+			}
+
+			if !reflect.DeepEqual(tt.exp, got) {
+				t.Errorf("expected URLs %v, got: %v", tt.exp, got)
+			}
+		})
+	}
+}
+
+func TestFindRequestLogsCaseInsensitive(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/Admin", nil)
+	if _, err := client.AddRequestLog(ctx, reqlog.Request{
+		Request:   *req,
+		Body:      []byte("role=Admin"),
+		Timestamp: time.Now(),
+	}); err != nil {
+		t.Fatalf("could not add request log: %v", err)
+	}
+
+	searchExpr, err := search.ParseQuery("admin")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	reExpr, err := search.ParseQuery(`req.body =~ "role=admin"`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		filter reqlog.FindRequestsFilter
+	}{
+		{name: "search expression", filter: reqlog.FindRequestsFilter{SearchExpr: searchExpr}},
+		{name: "regular expression", filter: reqlog.FindRequestsFilter{SearchExpr: reExpr}},
+		{name: "path prefix", filter: reqlog.FindRequestsFilter{PathPrefix: "/admin"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reqLogs, err := client.FindRequestLogs(ctx, tt.filter, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(reqLogs) != 0 {
+				t.Errorf("expected no case-sensitive matches, got: %v", len(reqLogs))
+			}
+
+			tt.filter.CaseInsensitive = true
+
+			reqLogs, err = client.FindRequestLogs(ctx, tt.filter, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(reqLogs) != 1 {
+				t.Errorf("expected 1 case-insensitive match, got: %v", len(reqLogs))
+			}
+		})
+	}
+}
+
+func TestRequestLogTLSState(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	tlsReq := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+	tlsReq.TLS = &tls.ConnectionState{
+		Version:     tls.VersionTLS13,
+		CipherSuite: tls.TLS_AES_128_GCM_SHA256,
+	}
+
+	plainReq := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+
+	tests := []struct {
+		name string
+		req  *http.Request
+		exp  *tls.ConnectionState
+	}{
+		{name: "with TLS", req: tlsReq, exp: tlsReq.TLS},
+		{name: "plaintext", req: plainReq, exp: nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *tt.req, Timestamp: time.Now()})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got, err := client.FindRequestLogByID(ctx, reqLog.ID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.exp, got.Request.TLS) {
+				t.Errorf("expected TLS state %+v, got: %+v", tt.exp, got.Request.TLS)
+			}
+		})
+	}
+}
+
+func TestOpenProjectAddsColumns(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	// Create a database with the initial `http_requests` schema.
+	db, err := sqlx.Open("sqlite3", filepath.Join(dir, "test.db"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = db.Exec(`CREATE TABLE http_requests (
+		id INTEGER PRIMARY KEY,
+		proto TEXT,
+		url TEXT,
+		method TEXT,
+		body BLOB,
+		timestamp DATETIME
+	)`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The driver stores the timestamp in its default format.
+	ts := time.Date(2021, 1, 2, 3, 4, 5, 123456000, time.FixedZone("UTC+5", 5*60*60))
+
+	_, err = db.Exec(`INSERT INTO http_requests (proto, url, method, body, timestamp)
+		VALUES ('HTTP/1.1', 'https://example.com/', 'GET', 'foo', ?)`, ts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	db.Close()
+
+	client, err := New(Config{ProjectsPath: dir})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := client.OpenProject("test"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer client.Close()
+
+	for _, col := range addedColumns {
+		var count int
+
+		err := client.db.Get(&count, `SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?`, col.table, col.name)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if count != 1 {
+			t.Errorf("expected column %v.%v to exist", col.table, col.name)
+		}
+	}
+
+	reqLog, err := client.FindRequestLogByID(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if exp := fingerprint("GET", "https://example.com/", []byte("foo")); reqLog.Fingerprint != exp {
+		t.Errorf("expected existing request log to be fingerprinted as %q, got: %q", exp, reqLog.Fingerprint)
+	}
+
+	var rawTimestamp string
+
+	if err := client.db.Get(&rawTimestamp, `SELECT CAST(timestamp AS TEXT) FROM http_requests WHERE id = 1`); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if exp := "2021-01-01T22:04:05.123456000Z"; rawTimestamp != exp {
+		t.Errorf("expected existing timestamp to be migrated to %q, got: %q", exp, rawTimestamp)
+	}
+
+	if !reqLog.Timestamp.Equal(ts) {
+		t.Errorf("expected timestamp %v, got: %v", ts, reqLog.Timestamp)
+	}
+}
+
+func TestOpenProjectFingerprintsNullBodies(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	db, err := sqlx.Open("sqlite3", filepath.Join(dir, "test.db"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = db.Exec(`CREATE TABLE http_requests (
+		id INTEGER PRIMARY KEY,
+		proto TEXT,
+		url TEXT,
+		method TEXT,
+		body BLOB,
+		timestamp DATETIME
+	)`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = db.Exec(`INSERT INTO http_requests (proto, url, method, body, timestamp)
+		VALUES ('HTTP/1.1', 'https://example.com/', 'GET', NULL, ?)`, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	db.Close()
+
+	client, err := New(Config{ProjectsPath: dir})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := client.OpenProject("test"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer client.Close()
+
+	reqLog, err := client.FindRequestLogByID(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Requests without a body are fingerprinted like new ones.
+	if exp := fingerprint("GET", "https://example.com/", nil); reqLog.Fingerprint != exp {
+		t.Errorf("expected existing request log to be fingerprinted as %q, got: %q", exp, reqLog.Fingerprint)
+	}
+}
+
+func TestTimestampsAreUTC(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	ts := time.Date(2021, 1, 2, 3, 4, 5, 0, time.FixedZone("UTC+5", 5*60*60))
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+	reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: ts})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res := http.Response{Status: "200 OK", StatusCode: 200, Header: http.Header{}}
+
+	if _, err := client.AddResponseLog(ctx, reqlog.Request{
+		ID:       reqLog.ID,
+		Response: &reqlog.Response{Response: res, Timestamp: ts},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := client.FindRequestLogByID(ctx, reqLog.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for name, gotTS := range map[string]time.Time{
+		"request":  got.Timestamp,
+		"response": got.Response.Timestamp,
+	} {
+		if !gotTS.Equal(ts) {
+			t.Errorf("expected %v timestamp %v, got: %v", name, ts, gotTS)
+		}
+
+		if gotTS.Location() != time.UTC {
+			t.Errorf("expected %v timestamp in UTC, got: %v", name, gotTS.Location())
+		}
+	}
+}
+
+func TestAddRequestLogAbsoluteURL(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	tlsReq := httptest.NewRequest(http.MethodGet, "/foo?bar=baz", nil)
+	tlsReq.Host = "example.com"
+	tlsReq.TLS = &tls.ConnectionState{}
+
+	plainReq := httptest.NewRequest(http.MethodGet, "/foo", nil)
+	plainReq.Host = "example.com:8080"
+
+	absReq := httptest.NewRequest(http.MethodGet, "http://example.com/foo", nil)
+
+	connectReq := httptest.NewRequest(http.MethodConnect, "https://example.com:443", nil)
+	connectReq.URL = &url.URL{Host: "example.com:443"}
+
+	tests := []struct {
+		name string
+		req  *http.Request
+		exp  string
+	}{
+		{name: "path-only URL with TLS", req: tlsReq, exp: "https://example.com/foo?bar=baz"},
+		{name: "path-only URL without TLS", req: plainReq, exp: "http://example.com:8080/foo"},
+		{name: "absolute URL", req: absReq, exp: "http://example.com/foo"},
+		{name: "CONNECT", req: connectReq, exp: "https://example.com:443"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *tt.req, Timestamp: time.Now()})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got, err := client.FindRequestLogByID(ctx, reqLog.ID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.Request.URL.String() != tt.exp {
+				t.Errorf("expected stored URL %q, got: %q", tt.exp, got.Request.URL.String())
+			}
+		})
+	}
+}
+
+func TestAddRequestLogHostHeader(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/foo", nil)
+	req.Host = "evil.example.org"
+
+	reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := client.FindRequestLogByID(ctx, reqLog.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if exp := "https://example.com/foo"; got.Request.URL.String() != exp {
+		t.Errorf("expected URL %q, got: %q", exp, got.Request.URL.String())
+	}
+
+	if exp := "evil.example.org"; got.Request.Host != exp {
+		t.Errorf("expected host header %q, got: %q", exp, got.Request.Host)
+	}
+}
+
+func TestAddResponseLogContentLengthMismatch(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		header http.Header
+		body   string
+		exp    bool
+	}{
+		{name: "matching length", header: http.Header{"Content-Length": []string{"3"}}, body: "foo", exp: false},
+		{name: "mismatching length", header: http.Header{"Content-Length": []string{"10"}}, body: "foo", exp: true},
+		{name: "chunked", header: http.Header{"Transfer-Encoding": []string{"chunked"}}, body: "foo", exp: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+			reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			res := http.Response{Status: "200 OK", StatusCode: http.StatusOK, Header: tt.header}
+
+			if _, err := client.AddResponseLog(ctx, reqlog.Request{
+				ID:       reqLog.ID,
+				Response: &reqlog.Response{Response: res, Body: []byte(tt.body), Timestamp: time.Now()},
+			}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got, err := client.FindRequestLogByID(ctx, reqLog.ID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.Response.ContentLengthMismatch != tt.exp {
+				t.Errorf("expected content length mismatch to be %v, got: %v", tt.exp, got.Response.ContentLengthMismatch)
+			}
+		})
+	}
+}
+
+func TestFindRequestLogsContentTypeFilters(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	for _, contentType := range []string{
+		"application/json; charset=utf-8",
+		"text/html; charset=utf-8",
+		"Application/JSON",
+		"text/html",
+	} {
+		req := httptest.NewRequest(http.MethodPost, "https://example.com/", nil)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+		if err != nil {
+			t.Fatalf("could not add request log: %v", err)
+		}
+
+		res := http.Response{
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{contentType}},
+		}
+
+		if _, err := client.AddResponseLog(ctx, reqlog.Request{
+			ID:       reqLog.ID,
+			Response: &reqlog.Response{Response: res, Timestamp: time.Now()},
+		}); err != nil {
+			t.Fatalf("could not add response log: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		filter reqlog.FindRequestsFilter
+		exp    int
+	}{
+		{name: "JSON responses", filter: reqlog.FindRequestsFilter{ResponseContentType: "application/json"}, exp: 2},
+		{name: "HTML responses", filter: reqlog.FindRequestsFilter{ResponseContentType: "text/html"}, exp: 2},
+		{name: "form requests", filter: reqlog.FindRequestsFilter{RequestContentType: "application/x-www-form"}, exp: 4},
+		{name: "JSON requests", filter: reqlog.FindRequestsFilter{RequestContentType: "application/json"}, exp: 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reqLogs, err := client.FindRequestLogs(ctx, tt.filter, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(reqLogs) != tt.exp {
+				t.Errorf("expected %v request logs, got: %v", tt.exp, len(reqLogs))
+			}
+		})
+	}
+}
+
+func TestDuplicateHeaders(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		collapse bool
+		exp      []string
+	}{
+		{name: "stored as received", collapse: false, exp: []string{"a=1", "b=2", "a=1"}},
+		{name: "collapsed", collapse: true, exp: []string{"a=1", "b=2"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := New(Config{ProjectsPath: t.TempDir(), CollapseDuplicateHeaders: tt.collapse})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if err := client.OpenProject("test"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer client.Close()
+
+			ctx := context.Background()
+
+			req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+			req.Header["Cookie"] = []string{"a=1", "b=2", "a=1"}
+
+			reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got, err := client.FindRequestLogByID(ctx, reqLog.ID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.exp, got.Request.Header["Cookie"]) {
+				t.Errorf("expected header values %v, got: %v", tt.exp, got.Request.Header["Cookie"])
+			}
+		})
+	}
+}
+
+func TestFindRequestLogsProtos(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	for _, proto := range []string{"HTTP/1.1", "HTTP/2.0", "HTTP/1.0", "HTTP/2.0"} {
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+		req.Proto = proto
+
+		if _, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()}); err != nil {
+			t.Fatalf("could not add request log: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		protos []string
+		exp    int
+	}{
+		{name: "HTTP/2.0 only", protos: []string{"HTTP/2.0"}, exp: 2},
+		{name: "shorthand version", protos: []string{"http/2"}, exp: 2},
+		{name: "multiple versions", protos: []string{"HTTP/1.0", "HTTP/1.1"}, exp: 2},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reqLogs, err := client.FindRequestLogs(ctx, reqlog.FindRequestsFilter{Protos: tt.protos}, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(reqLogs) != tt.exp {
+				t.Errorf("expected %v request logs, got: %v", tt.exp, len(reqLogs))
+			}
+		})
+	}
+}
+
+func TestScanPartialColumns(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	addTestRequestLogs(t, client, 1)
+
+	// A request log with `NULL` values, as could be left behind by older
+	// versions.
+	if _, err := client.db.Exec(`INSERT INTO http_requests (method) VALUES ('POST')`); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		cols []string
+	}{
+		{name: "id and method", cols: []string{"req.id AS req_id", "req.method"}},
+		{name: "id only", cols: []string{"req.id AS req_id"}},
+		{name: "all columns", cols: allHTTPRequestLogsQuery().requestCols},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reqQuery, err := findRequestLogsQuery(httpRequestLogsQuery{
+				requestCols:  tt.cols,
+				joinResponse: true,
+			}, reqlog.FindRequestsFilter{}, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var dtos []httpRequest
+
+			sql, args, err := reqQuery.ToSql()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if err := client.db.SelectContext(ctx, &dtos, sql, args...); err != nil {
+				t.Fatalf("unexpected scan error: %v", err)
+			}
+
+			if len(dtos) != 2 {
+				t.Fatalf("expected 2 request logs, got: %v", len(dtos))
+			}
+
+			for _, dto := range dtos {
+				if dto.ID == 0 {
+					t.Errorf("expected request log ID to be scanned")
+				}
+
+				dto.toRequestLog()
+			}
+		})
+	}
+}
+
+func TestPing(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	if err := client.Ping(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := client.db.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := client.Ping(ctx); err == nil {
+		t.Error("expected error for closed database")
+	}
+}
+
+func TestAddResponseLogSkipBody(t *testing.T) {
+	t.Parallel()
+
+	client, err := New(Config{
+		ProjectsPath:         t.TempDir(),
+		SkipBodyContentTypes: []string{"image/", "font/", "video/"},
+	})
+	if err != nil {
+		t.Fatalf("could not create client: %v", err)
+	}
+
+	if err := client.OpenProject("test"); err != nil {
+		t.Fatalf("could not open project: %v", err)
+	}
+
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		contentType string
+		expSkipped  bool
+	}{
+		{name: "image", contentType: "image/png", expSkipped: true},
+		{name: "case-insensitive", contentType: "Font/WOFF2", expSkipped: true},
+		{name: "text", contentType: "text/html; charset=utf-8", expSkipped: false},
+		{name: "no content type", contentType: "", expSkipped: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+			reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			res := http.Response{
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"X-Foo": []string{"bar"}},
+			}
+			if tt.contentType != "" {
+				res.Header.Set("Content-Type", tt.contentType)
+			}
+
+			if _, err := client.AddResponseLog(ctx, reqlog.Request{
+				ID:       reqLog.ID,
+				Response: &reqlog.Response{Response: res, Body: []byte("foobar"), Timestamp: time.Now()},
+			}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got, err := client.FindRequestLogByID(ctx, reqLog.ID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.Response.BodySkipped != tt.expSkipped {
+				t.Errorf("expected body skipped to be %v, got: %v", tt.expSkipped, got.Response.BodySkipped)
+			}
+
+			if tt.expSkipped && got.Response.Body != nil {
+				t.Errorf("expected body to be dropped, got: %q", got.Response.Body)
+			}
+
+			if !tt.expSkipped && string(got.Response.Body) != "foobar" {
+				t.Errorf("expected body %q, got: %q", "foobar", got.Response.Body)
+			}
+
+			if got.Response.Response.Header.Get("X-Foo") != "bar" {
+				t.Errorf("expected headers to be stored, got: %v", got.Response.Response.Header)
+			}
+		})
+	}
+}
+
+func TestTimestampsSubSecondPrecision(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	// Timestamps within the same second, inserted in reverse order.
+	earlier := time.Date(2021, 1, 2, 3, 4, 5, 1000, time.UTC)
+	later := earlier.Add(time.Microsecond)
+
+	var ids []int64
+
+	for _, ts := range []time.Time{later, earlier} {
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+		reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: ts})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		ids = append(ids, reqLog.ID)
+	}
+
+	for i, exp := range []time.Time{later, earlier} {
+		got, err := client.FindRequestLogByID(ctx, ids[i])
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !got.Timestamp.Equal(exp) {
+			t.Errorf("expected timestamp %v, got: %v", exp, got.Timestamp)
+		}
+	}
+
+	var orderedIDs []int64
+
+	if err := client.db.Select(&orderedIDs, `SELECT id FROM http_requests ORDER BY timestamp`); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if exp := []int64{ids[1], ids[0]}; !reflect.DeepEqual(exp, orderedIDs) {
+		t.Errorf("expected request logs ordered by timestamp to be %v, got: %v", exp, orderedIDs)
+	}
+}
+
+func TestAddRequestResponse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("stores request and response", func(t *testing.T) {
+		t.Parallel()
+
+		client := newTestClient(t)
+		ctx := context.Background()
+
+		req := httptest.NewRequest(http.MethodPost, "https://example.com/", nil)
+		req.Header.Set("X-Foo", "bar")
+
+		res := http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+			Proto:      "HTTP/1.1",
+			Header:     http.Header{"X-Bar": []string{"baz"}},
+		}
+
+		reqLog, err := client.AddRequestResponse(ctx, reqlog.Request{
+			Request:   *req,
+			Body:      []byte("foo"),
+			Timestamp: time.Now(),
+			Response:  &reqlog.Response{Response: res, Body: []byte("bar")},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got, err := client.FindRequestLogByID(ctx, reqLog.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got.Response == nil {
+			t.Fatal("expected response log")
+		}
+
+		if got.Response.ID != reqLog.Response.ID || got.Response.Response.StatusCode != http.StatusCreated {
+			t.Errorf("unexpected response log: %+v", got.Response)
+		}
+
+		if string(got.Body) != "foo" || string(got.Response.Body) != "bar" {
+			t.Errorf("expected bodies %q and %q, got: %q and %q", "foo", "bar", got.Body, got.Response.Body)
+		}
+
+		if got.Request.Header.Get("X-Foo") != "bar" || got.Response.Response.Header.Get("X-Bar") != "baz" {
+			t.Errorf("expected headers to be stored, got: %v and %v", got.Request.Header, got.Response.Response.Header)
+		}
+	})
+
+	t.Run("rolls back on failure", func(t *testing.T) {
+		t.Parallel()
+
+		client := newTestClient(t)
+		ctx := context.Background()
+
+		// Make inserting the response fail, after the request was inserted.
+		_, err := client.db.Exec(`CREATE TRIGGER fail_response BEFORE INSERT ON http_responses
+			BEGIN SELECT RAISE(ABORT, 'foobar'); END`)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+		req.Header.Set("X-Foo", "bar")
+
+		res := http.Response{Status: "200 OK", StatusCode: http.StatusOK, Header: http.Header{}}
+
+		if _, err := client.AddRequestResponse(ctx, reqlog.Request{
+			Request:   *req,
+			Timestamp: time.Now(),
+			Response:  &reqlog.Response{Response: res},
+		}); err == nil {
+			t.Fatal("expected error")
+		}
+
+		for _, table := range []string{"http_requests", "http_responses", "http_headers"} {
+			var count int
+
+			if err := client.db.Get(&count, "SELECT COUNT(*) FROM "+table); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if count != 0 {
+				t.Errorf("expected no rows in %v, got: %v", table, count)
+			}
+		}
+	})
+}
+
+func TestHeaderLimits(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	t.Run("header count", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+		reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		header := make(http.Header)
+		for i := 0; i < 10000; i++ {
+			header.Add("X-Foo-"+strconv.Itoa(i), "bar")
+		}
+
+		res := http.Response{Status: "200 OK", StatusCode: http.StatusOK, Header: header}
+
+		if _, err := client.AddResponseLog(ctx, reqlog.Request{
+			ID:       reqLog.ID,
+			Response: &reqlog.Response{Response: res, Timestamp: time.Now()},
+		}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got, err := client.FindRequestLogByID(ctx, reqLog.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !got.Response.HeadersTruncated {
+			t.Error("expected response log to be flagged as having truncated headers")
+		}
+
+		if got.HeadersTruncated {
+			t.Error("expected request log not to be flagged as having truncated headers")
+		}
+
+		if n := len(got.Response.Response.Header); n != defaultMaxHeaders {
+			t.Errorf("expected %v stored headers, got: %v", defaultMaxHeaders, n)
+		}
+
+		// Keys are stored in sorted order, so the last keys are dropped.
+		if got.Response.Response.Header.Get("X-Foo-0") == "" {
+			t.Error("expected first header in sorted order to be stored")
+		}
+
+		if got.Response.Response.Header.Get("X-Foo-9999") != "" {
+			t.Error("expected last header in sorted order to be dropped")
+		}
+	})
+
+	t.Run("header value length", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+		req.Header.Set("X-Foo", strings.Repeat("a", defaultMaxHeaderValueLength+1))
+
+		reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reqLog.HeadersTruncated {
+			t.Error("expected request log to be flagged as having truncated headers")
+		}
+
+		got, err := client.FindRequestLogByID(ctx, reqLog.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !got.HeadersTruncated {
+			t.Error("expected stored request log to be flagged as having truncated headers")
+		}
+
+		if n := len(got.Request.Header.Get("X-Foo")); n != defaultMaxHeaderValueLength {
+			t.Errorf("expected header value length %v, got: %v", defaultMaxHeaderValueLength, n)
+		}
+	})
+
+	t.Run("multi-byte header value", func(t *testing.T) {
+		t.Parallel()
+
+		// The limit falls in the middle of the 3-byte encoded "€".
+		value := strings.Repeat("a", defaultMaxHeaderValueLength-1) + "€"
+
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+		req.Header.Set("X-Foo", value)
+
+		reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got, err := client.FindRequestLogByID(ctx, reqLog.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if exp := value[:defaultMaxHeaderValueLength-1]; got.Request.Header.Get("X-Foo") != exp {
+			t.Errorf("expected header value to be cut before the multi-byte character, got length: %v",
+				len(got.Request.Header.Get("X-Foo")))
+		}
+	})
+}
+
+func TestFindRequestLogsResponseSize(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	sizes := []int{0, 10, 100, 1000}
+	ids := make(map[int]int64)
+
+	for _, size := range sizes {
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+		reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+		if err != nil {
+			t.Fatalf("could not add request log: %v", err)
+		}
+
+		var body []byte
+		if size > 0 {
+			body = []byte(strings.Repeat("a", size))
+		}
+
+		res := http.Response{Status: "200 OK", StatusCode: http.StatusOK, Header: http.Header{}}
+
+		if _, err := client.AddResponseLog(ctx, reqlog.Request{
+			ID:       reqLog.ID,
+			Response: &reqlog.Response{Response: res, Body: body, Timestamp: time.Now()},
+		}); err != nil {
+			t.Fatalf("could not add response log: %v", err)
+		}
+
+		ids[size] = reqLog.ID
+	}
+
+	// Request logs without a response shouldn't match.
+	addTestRequestLogs(t, client, 1)
+
+	int64Ptr := func(i int64) *int64 { return &i }
+
+	tests := []struct {
+		name string
+		min  *int64
+		max  *int64
+		exp  []int64
+	}{
+		{name: "larger than", min: int64Ptr(11), exp: []int64{ids[1000], ids[100]}},
+		{name: "empty", max: int64Ptr(0), exp: []int64{ids[0]}},
+		{name: "range", min: int64Ptr(10), max: int64Ptr(100), exp: []int64{ids[100], ids[10]}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter := reqlog.FindRequestsFilter{ResponseSizeMin: tt.min, ResponseSizeMax: tt.max}
+
+			reqLogs, err := client.FindRequestLogs(ctx, filter, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := make([]int64, len(reqLogs))
+			for i, reqLog := range reqLogs {
+				got[i] = reqLog.ID
+			}
+
+			if !reflect.DeepEqual(tt.exp, got) {
+				t.Errorf("expected request logs %v, got: %v", tt.exp, got)
+			}
+		})
+	}
+}
+
+func TestFindRequestLogsStatusClass(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	statusCodes := []int{101, 200, 302, 404, 500, 503}
+	ids := make(map[int]int64)
+
+	for _, statusCode := range statusCodes {
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+		reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+		if err != nil {
+			t.Fatalf("could not add request log: %v", err)
+		}
+
+		res := http.Response{StatusCode: statusCode, Header: http.Header{}}
+
+		if _, err := client.AddResponseLog(ctx, reqlog.Request{
+			ID:       reqLog.ID,
+			Response: &reqlog.Response{Response: res, Timestamp: time.Now()},
+		}); err != nil {
+			t.Fatalf("could not add response log: %v", err)
+		}
+
+		ids[statusCode] = reqLog.ID
+	}
+
+	// Request logs without a response shouldn't match.
+	addTestRequestLogs(t, client, 1)
+
+	tests := []struct {
+		name    string
+		classes []reqlog.StatusClass
+		exp     []int64
+	}{
+		{
+			name:    "server error",
+			classes: []reqlog.StatusClass{reqlog.StatusClassServerError},
+			exp:     []int64{ids[503], ids[500]},
+		},
+		{
+			name:    "informational or redirect",
+			classes: []reqlog.StatusClass{reqlog.StatusClassInformational, reqlog.StatusClassRedirect},
+			exp:     []int64{ids[302], ids[101]},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter := reqlog.FindRequestsFilter{StatusClasses: tt.classes}
+
+			reqLogs, err := client.FindRequestLogs(ctx, filter, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := make([]int64, len(reqLogs))
+			for i, reqLog := range reqLogs {
+				got[i] = reqLog.ID
+			}
+
+			if !reflect.DeepEqual(tt.exp, got) {
+				t.Errorf("expected request logs %v, got: %v", tt.exp, got)
+			}
+		})
+	}
+
+	t.Run("unknown class", func(t *testing.T) {
+		t.Parallel()
+
+		filter := reqlog.FindRequestsFilter{StatusClasses: []reqlog.StatusClass{"FOO"}}
+
+		if _, err := client.FindRequestLogs(ctx, filter, nil); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestSettingsTimestamps(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	if _, _, err := client.FindSettingsTimestamps(ctx, "scope"); !errors.Is(err, proj.ErrNoSettings) {
+		t.Fatalf("expected error %v, got: %v", proj.ErrNoSettings, err)
+	}
+
+	if err := client.UpsertSettings(ctx, "scope", []string{"foo"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	createdAt, updatedAt, err := client.FindSettingsTimestamps(ctx, "scope")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if createdAt.IsZero() || !createdAt.Equal(updatedAt) {
+		t.Fatalf("expected equal, non-zero timestamps after insert, got: %v and %v", createdAt, updatedAt)
+	}
+
+	if createdAt.Location() != time.UTC {
+		t.Errorf("expected timestamps in UTC, got: %v", createdAt.Location())
+	}
+
+	time.Sleep(time.Millisecond)
+
+	if err := client.UpsertSettings(ctx, "scope", []string{"bar"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	gotCreatedAt, gotUpdatedAt, err := client.FindSettingsTimestamps(ctx, "scope")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !gotCreatedAt.Equal(createdAt) {
+		t.Errorf("expected created at to stay %v, got: %v", createdAt, gotCreatedAt)
+	}
+
+	if !gotUpdatedAt.After(updatedAt) {
+		t.Errorf("expected updated at to be after %v, got: %v", updatedAt, gotUpdatedAt)
+	}
+}
+
+func TestSavedSearchTimestamps(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	saved, err := client.SaveSearch(ctx, "foo", reqlog.FindRequestsFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := client.FindSavedSearchByID(ctx, saved.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.CreatedAt.IsZero() || !got.CreatedAt.Equal(saved.CreatedAt) || !got.UpdatedAt.Equal(saved.UpdatedAt) {
+		t.Errorf("expected stored timestamps to be %v and %v, got: %v and %v",
+			saved.CreatedAt, saved.UpdatedAt, got.CreatedAt, got.UpdatedAt)
+	}
+}
+
+func TestFindRequestLogsJSONPathMatch(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	bodies := []string{
+		`{"user": {"role": "admin", "id": 42, "groups": ["dev", "ops"]}}`,
+		`{"user": {"role": "guest", "id": 7, "groups": []}}`,
+		`not JSON`,
+		``,
+	}
+	ids := make([]int64, len(bodies))
+
+	for i, body := range bodies {
+		req := httptest.NewRequest(http.MethodPost, "https://example.com/", nil)
+
+		reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Body: []byte(body), Timestamp: time.Now()})
+		if err != nil {
+			t.Fatalf("could not add request log: %v", err)
+		}
+
+		ids[i] = reqLog.ID
+	}
+
+	tests := []struct {
+		name   string
+		match  reqlog.JSONPathMatch
+		exp    []int64
+		expErr bool
+	}{
+		{name: "nested string", match: reqlog.JSONPathMatch{Path: "user.role", Value: "admin"}, exp: []int64{ids[0]}},
+		{name: "root prefix", match: reqlog.JSONPathMatch{Path: "$.user.role", Value: "guest"}, exp: []int64{ids[1]}},
+		{name: "number", match: reqlog.JSONPathMatch{Path: "user.id", Value: "7"}, exp: []int64{ids[1]}},
+		{name: "array index", match: reqlog.JSONPathMatch{Path: "user.groups[1]", Value: "ops"}, exp: []int64{ids[0]}},
+		{name: "JSON pointer", match: reqlog.JSONPathMatch{Path: "/user/groups/0", Value: "dev"}, exp: []int64{ids[0]}},
+		{name: "missing path", match: reqlog.JSONPathMatch{Path: "user.name", Value: "admin"}, exp: []int64{}},
+		{name: "invalid path", match: reqlog.JSONPathMatch{Path: "user..role", Value: "admin"}, expErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			match := tt.match
+
+			reqLogs, err := client.FindRequestLogs(ctx, reqlog.FindRequestsFilter{JSONPathMatch: &match}, nil)
+			if tt.expErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := make([]int64, len(reqLogs))
+			for i, reqLog := range reqLogs {
+				got[i] = reqLog.ID
+			}
+
+			if !reflect.DeepEqual(tt.exp, got) {
+				t.Errorf("expected request logs %v, got: %v", tt.exp, got)
+			}
+		})
+	}
+}
+
+func TestFindRequestLogsInScope(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	projService, err := proj.NewService(client)
+	if err != nil {
+		t.Fatalf("could not create project service: %v", err)
+	}
+
+	rules := []scope.Rule{
+		{URL: regexp.MustCompile(`^https://example\.com/`)},
+		{Header: scope.Header{Key: regexp.MustCompile(`(?i)^x-scope$`), Value: regexp.MustCompile(`^yes$`)}},
+		{Body: regexp.MustCompile(`token=`)},
+	}
+
+	withRules := scope.New(client, projService)
+	if err := withRules.SetRules(ctx, rules); err != nil {
+		t.Fatalf("could not set scope rules: %v", err)
+	}
+
+	withoutRules := scope.New(client, projService)
+
+	addReqLog := func(rawURL string, header http.Header, body string) int64 {
+		req := httptest.NewRequest(http.MethodPost, rawURL, nil)
+		for key, values := range header {
+			req.Header[key] = values
+		}
+
+		var b []byte
+		if body != "" {
+			b = []byte(body)
+		}
+
+		return addTestRequestLog(t, client, reqlog.Request{Request: *req, Body: b}).ID
+	}
+
+	byURL := addReqLog("https://example.com/foo", nil, "")
+	byHeader := addReqLog("https://other.test/", http.Header{"X-Scope": []string{"yes"}}, "")
+	byBody := addReqLog("https://other.test/", nil, "token=abc")
+	headerValueMismatch := addReqLog("https://other.test/", http.Header{"X-Scope": []string{"no"}}, "")
+	noMatch := addReqLog("https://noise.test/", http.Header{"X-Foo": []string{"yes"}}, "")
+
+	boolPtr := func(b bool) *bool { return &b }
+
+	tests := []struct {
+		name    string
+		scope   *scope.Scope
+		inScope *bool
+		exp     []int64
+	}{
+		{
+			name:    "in scope",
+			scope:   withRules,
+			inScope: boolPtr(true),
+			exp:     []int64{byBody, byHeader, byURL},
+		},
+		{
+			name:    "out of scope",
+			scope:   withRules,
+			inScope: boolPtr(false),
+			exp:     []int64{noMatch, headerValueMismatch},
+		},
+		{
+			name:  "unset",
+			scope: withRules,
+			exp:   []int64{noMatch, headerValueMismatch, byBody, byHeader, byURL},
+		},
+		{
+			name:    "in scope without rules",
+			scope:   withoutRules,
+			inScope: boolPtr(true),
+			exp:     []int64{},
+		},
+		{
+			name:    "out of scope without rules",
+			scope:   withoutRules,
+			inScope: boolPtr(false),
+			exp:     []int64{noMatch, headerValueMismatch, byBody, byHeader, byURL},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reqLogs, err := client.FindRequestLogs(ctx, reqlog.FindRequestsFilter{InScope: tt.inScope}, tt.scope)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := make([]int64, len(reqLogs))
+			for i, reqLog := range reqLogs {
+				got[i] = reqLog.ID
+			}
+
+			if !reflect.DeepEqual(tt.exp, got) {
+				t.Errorf("expected request logs %v, got: %v", tt.exp, got)
+			}
+		})
+	}
+}
+
+func TestFindRequestLogsInScopeByMethod(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	projService, err := proj.NewService(client)
+	if err != nil {
+		t.Fatalf("could not create project service: %v", err)
+	}
+
+	scopeSvc := scope.New(client, projService)
+
+	rules := []scope.Rule{
+		{URL: regexp.MustCompile(`^https://example\.com/`), Methods: []string{http.MethodPost}},
+	}
+	if err := scopeSvc.SetRules(ctx, rules); err != nil {
+		t.Fatalf("could not set scope rules: %v", err)
+	}
+
+	addReqLog := func(method, rawURL string) int64 {
+		req := httptest.NewRequest(method, rawURL, nil)
+		reqLog := addTestRequestLog(t, client, reqlog.Request{Request: *req})
+
+		if exp, got := method == http.MethodPost && strings.HasPrefix(rawURL, "https://example.com/"),
+			scopeSvc.Match(req, nil); exp != got {
+			t.Errorf("expected %v %v to match scope: %v, got: %v", method, rawURL, exp, got)
+		}
+
+		return reqLog.ID
+	}
+
+	postToHost := addReqLog(http.MethodPost, "https://example.com/foo")
+	getToHost := addReqLog(http.MethodGet, "https://example.com/foo")
+	postToOtherHost := addReqLog(http.MethodPost, "https://other.test/foo")
+
+	inScope := true
+
+	reqLogs, err := client.FindRequestLogs(ctx, reqlog.FindRequestsFilter{InScope: &inScope}, scopeSvc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reqLogs) != 1 || reqLogs[0].ID != postToHost {
+		t.Errorf("expected only request log %v in scope, got: %+v", postToHost, reqLogs)
+	}
+
+	inScope = false
+
+	reqLogs, err = client.FindRequestLogs(ctx, reqlog.FindRequestsFilter{InScope: &inScope}, scopeSvc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := make([]int64, len(reqLogs))
+	for i, reqLog := range reqLogs {
+		got[i] = reqLog.ID
+	}
+
+	if exp := []int64{postToOtherHost, getToHost}; !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected request logs %v out of scope, got: %v", exp, got)
+	}
+}
+
+func TestWithTx(t *testing.T) {
+	t.Parallel()
+
+	errAbort := errors.New("abort")
+
+	countRequestLogs := func(t *testing.T, client *Client, rawURL string) int {
+		t.Helper()
+
+		var n int
+		if err := client.db.Get(&n, `SELECT COUNT(*) FROM http_requests WHERE url = ?`, rawURL); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		return n
+	}
+
+	t.Run("commits on nil error", func(t *testing.T) {
+		t.Parallel()
+
+		client := newTestClient(t)
+		ctx := context.Background()
+
+		var reqID int64
+
+		err := client.WithTx(ctx, func(repo reqlog.Repository) error {
+			req := httptest.NewRequest(http.MethodGet, "https://example.com/commit", nil)
+
+			reqLog, err := repo.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+			if err != nil {
+				return err
+			}
+
+			reqID = reqLog.ID
+
+			return repo.SetMetadata(ctx, reqLog.ID, "foo", []byte(`"bar"`))
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if n := countRequestLogs(t, client, "https://example.com/commit"); n != 1 {
+			t.Errorf("expected 1 request log, got: %v", n)
+		}
+
+		metadata, err := client.GetMetadata(ctx, reqID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if string(metadata["foo"]) != `"bar"` {
+			t.Errorf("expected metadata to be stored, got: %v", metadata)
+		}
+	})
+
+	t.Run("rolls back on error", func(t *testing.T) {
+		t.Parallel()
+
+		client := newTestClient(t)
+		ctx := context.Background()
+
+		err := client.WithTx(ctx, func(repo reqlog.Repository) error {
+			req := httptest.NewRequest(http.MethodGet, "https://example.com/rollback", nil)
+			req.Header.Set("X-Foo", "bar")
+
+			reqLog, err := repo.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+			if err != nil {
+				return err
+			}
+
+			res := http.Response{Status: "200 OK", StatusCode: http.StatusOK}
+
+			if _, err := repo.AddResponseLog(ctx, reqlog.Request{
+				ID:       reqLog.ID,
+				Response: &reqlog.Response{Response: res, Body: []byte("foo"), Timestamp: time.Now()},
+			}); err != nil {
+				return err
+			}
+
+			return errAbort
+		})
+		if !errors.Is(err, errAbort) {
+			t.Fatalf("expected error %v, got: %v", errAbort, err)
+		}
+
+		if n := countRequestLogs(t, client, "https://example.com/rollback"); n != 0 {
+			t.Errorf("expected no request logs, got: %v", n)
+		}
+
+		var n int
+		if err := client.db.Get(&n, `SELECT (SELECT COUNT(*) FROM http_responses) + (SELECT COUNT(*) FROM http_headers)`); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if n != 0 {
+			t.Errorf("expected no response logs or headers, got: %v rows", n)
+		}
+	})
+
+	t.Run("nested transaction rolls back to savepoint", func(t *testing.T) {
+		t.Parallel()
+
+		client := newTestClient(t)
+		ctx := context.Background()
+
+		err := client.WithTx(ctx, func(repo reqlog.Repository) error {
+			req := httptest.NewRequest(http.MethodGet, "https://example.com/outer", nil)
+			if _, err := repo.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()}); err != nil {
+				return err
+			}
+
+			err := repo.WithTx(ctx, func(repo reqlog.Repository) error {
+				req := httptest.NewRequest(http.MethodGet, "https://example.com/inner", nil)
+				if _, err := repo.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()}); err != nil {
+					return err
+				}
+
+				return errAbort
+			})
+			if !errors.Is(err, errAbort) {
+				return fmt.Errorf("expected error %v, got: %v", errAbort, err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if n := countRequestLogs(t, client, "https://example.com/outer"); n != 1 {
+			t.Errorf("expected outer request log to be committed, got: %v", n)
+		}
+
+		if n := countRequestLogs(t, client, "https://example.com/inner"); n != 0 {
+			t.Errorf("expected inner request log to be rolled back, got: %v", n)
+		}
+	})
+}
+
+func TestFindRecentRequestLogs(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	addTestRequestLogs(t, client, 5)
+
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/newest", nil)
+	req.Header.Set("X-Foo", "bar")
+
+	res := http.Response{
+		Status:     "201 Created",
+		StatusCode: http.StatusCreated,
+		Header:     http.Header{"Server": []string{"nginx"}},
+	}
+
+	newest, err := client.AddRequestResponse(ctx, reqlog.Request{
+		Request:   *req,
+		Body:      []byte("foo"),
+		Timestamp: time.Now(),
+		Response:  &reqlog.Response{Response: res, Body: []byte("bar")},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := client.FindRecentRequestLogs(ctx, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(got) != 3 {
+		t.Fatalf("expected 3 request logs, got: %v", len(got))
+	}
+
+	for i := 1; i < len(got); i++ {
+		if got[i].ID >= got[i-1].ID {
+			t.Errorf("expected request logs ordered by ID (descending), got: %v, %v", got[i-1].ID, got[i].ID)
+		}
+	}
+
+	if got[0].ID != newest.ID {
+		t.Errorf("expected newest request log (%v) first, got: %v", newest.ID, got[0].ID)
+	}
+
+	if got[0].Request.Method != http.MethodPost || got[0].Request.URL.String() != "https://example.com/newest" {
+		t.Errorf("unexpected method and URL: %v %v", got[0].Request.Method, got[0].Request.URL)
+	}
+
+	if got[0].Response == nil || got[0].Response.Response.StatusCode != http.StatusCreated {
+		t.Fatalf("expected response status code %v, got: %+v", http.StatusCreated, got[0].Response)
+	}
+
+	for _, reqLog := range got {
+		if reqLog.Request.Header != nil {
+			t.Errorf("expected request headers not to be fetched, got: %v", reqLog.Request.Header)
+		}
+
+		if reqLog.Body != nil {
+			t.Errorf("expected request body not to be fetched, got: %q", reqLog.Body)
+		}
+
+		if reqLog.Response != nil && reqLog.Response.Response.Header != nil {
+			t.Errorf("expected response headers not to be fetched, got: %v", reqLog.Response.Response.Header)
+		}
+	}
+}
+
+func TestFindRequestLogsHeaderMatch(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	addReqLog := func(reqHeader, resHeader http.Header) int64 {
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+		for key, values := range reqHeader {
+			req.Header[key] = values
+		}
+
+		reqLog := reqlog.Request{Request: *req}
+		if resHeader != nil {
+			res := http.Response{Status: "200 OK", StatusCode: http.StatusOK, Header: resHeader}
+			reqLog.Response = &reqlog.Response{Response: res}
+		}
+
+		return addTestRequestLog(t, client, reqLog).ID
+	}
+
+	// The `Server` request header must not match response header filters.
+	nginx := addReqLog(nil, http.Header{"Server": []string{"nginx/1.21.0"}})
+	apache := addReqLog(http.Header{"Server": []string{"nginx"}}, http.Header{"Server": []string{"Apache"}})
+	noServer := addReqLog(http.Header{"X-Foo": []string{"Bar"}}, http.Header{"X-Foo": []string{"bar"}})
+	noResponse := addReqLog(http.Header{"X-Foo": []string{"bar"}}, nil)
+
+	tests := []struct {
+		name   string
+		filter reqlog.FindRequestsFilter
+		exp    []int64
+	}{
+		{
+			name:   "response header value",
+			filter: reqlog.FindRequestsFilter{ResponseHeaders: []reqlog.HeaderMatch{{Key: "server", Value: "nginx"}}},
+			exp:    []int64{nginx},
+		},
+		{
+			name:   "response header key only",
+			filter: reqlog.FindRequestsFilter{ResponseHeaders: []reqlog.HeaderMatch{{Key: "Server"}}},
+			exp:    []int64{apache, nginx},
+		},
+		{
+			name: "multiple response headers",
+			filter: reqlog.FindRequestsFilter{ResponseHeaders: []reqlog.HeaderMatch{
+				{Key: "Server"},
+				{Key: "Server", Value: "pache"},
+			}},
+			exp: []int64{apache},
+		},
+		{
+			name:   "request header value",
+			filter: reqlog.FindRequestsFilter{RequestHeaders: []reqlog.HeaderMatch{{Key: "X-Foo", Value: "bar"}}},
+			exp:    []int64{noResponse},
+		},
+		{
+			name: "request header value case-insensitive",
+			filter: reqlog.FindRequestsFilter{
+				RequestHeaders:  []reqlog.HeaderMatch{{Key: "X-Foo", Value: "bar"}},
+				CaseInsensitive: true,
+			},
+			exp: []int64{noResponse, noServer},
+		},
+		{
+			name: "request and response headers",
+			filter: reqlog.FindRequestsFilter{
+				RequestHeaders:  []reqlog.HeaderMatch{{Key: "X-Foo"}},
+				ResponseHeaders: []reqlog.HeaderMatch{{Key: "X-Foo"}},
+			},
+			exp: []int64{noServer},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reqLogs, err := client.FindRequestLogs(ctx, tt.filter, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := make([]int64, len(reqLogs))
+			for i, reqLog := range reqLogs {
+				got[i] = reqLog.ID
+			}
+
+			if !reflect.DeepEqual(tt.exp, got) {
+				t.Errorf("expected request logs %v, got: %v", tt.exp, got)
+			}
+		})
+	}
+}
+
+func TestAddRequestLogNormalizeURL(t *testing.T) {
+	t.Parallel()
+
+	newClient := func(t *testing.T, normalize bool) *Client {
+		t.Helper()
+
+		client, err := New(Config{
+			ProjectsPath:     t.TempDir(),
+			NormalizeURLs:    normalize,
+			StripQueryParams: DefaultStripQueryParams,
+		})
+		if err != nil {
+			t.Fatalf("could not create client: %v", err)
+		}
+
+		if err := client.OpenProject("test"); err != nil {
+			t.Fatalf("could not open project: %v", err)
+		}
+
+		t.Cleanup(func() { client.Close() })
+
+		return client
+	}
+
+	addReqLog := func(t *testing.T, client *Client, rawURL string) reqlog.Request {
+		t.Helper()
+
+		req := httptest.NewRequest(http.MethodGet, rawURL, nil)
+		reqLog := addTestRequestLog(t, client, reqlog.Request{Request: *req})
+
+		got, err := client.FindRequestLogByID(context.Background(), reqLog.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got.Fingerprint != reqLog.Fingerprint {
+			t.Errorf("expected stored fingerprint %q, got: %q", reqLog.Fingerprint, got.Fingerprint)
+		}
+
+		return got
+	}
+
+	t.Run("normalized", func(t *testing.T) {
+		t.Parallel()
+
+		client := newClient(t, true)
+
+		a := addReqLog(t, client, "https://example.com/foo?b=2&a=1&utm_source=newsletter")
+		b := addReqLog(t, client, "https://example.com/foo?a=1&b=2&UTM_MEDIUM=email&fbclid=abc")
+		c := addReqLog(t, client, "https://example.com/foo?a=1&b=3")
+
+		if a.Fingerprint != b.Fingerprint {
+			t.Errorf("expected URLs differing in param order and tracking params to have the same fingerprint")
+		}
+
+		if a.Fingerprint == c.Fingerprint {
+			t.Errorf("expected URLs with different param values to have different fingerprints")
+		}
+
+		if exp := "https://example.com/foo?a=1&b=2"; a.CanonicalURL != exp {
+			t.Errorf("expected canonical URL %q, got: %q", exp, a.CanonicalURL)
+		}
+
+		if exp := "https://example.com/foo?b=2&a=1&utm_source=newsletter"; a.Request.URL.String() != exp {
+			t.Errorf("expected original URL %q to be kept, got: %q", exp, a.Request.URL.String())
+		}
+
+		dups, err := client.FindDuplicateRequestLogs(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(dups) != 1 || len(dups[0].RequestIDs) != 2 {
+			t.Errorf("expected 1 group of 2 duplicate request logs, got: %+v", dups)
+		}
+	})
+
+	t.Run("not normalized", func(t *testing.T) {
+		t.Parallel()
+
+		client := newClient(t, false)
+
+		a := addReqLog(t, client, "https://example.com/foo?b=2&a=1")
+		b := addReqLog(t, client, "https://example.com/foo?a=1&b=2")
+
+		if a.Fingerprint == b.Fingerprint {
+			t.Errorf("expected URLs differing in param order to have different fingerprints")
+		}
+
+		if a.CanonicalURL != "" {
+			t.Errorf("expected no canonical URL, got: %q", a.CanonicalURL)
+		}
+	})
+}
+
+func TestAddResponseLogStatusReason(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		status    string
+		expReason string
+		expStatus string
+	}{
+		{status: "200 OK", expReason: "OK", expStatus: "200 OK"},
+		{status: "404 Not Found", expReason: "Not Found", expStatus: "404 Not Found"},
+		{status: "200", expReason: "", expStatus: "200"},
+		{status: "", expReason: "", expStatus: "200"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.status, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+			reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+			if err != nil {
+				t.Fatalf("could not add request log: %v", err)
+			}
+
+			code := http.StatusOK
+			if strings.HasPrefix(tt.status, "404") {
+				code = http.StatusNotFound
+			}
+
+			res := http.Response{Status: tt.status, StatusCode: code}
+
+			if _, err := client.AddResponseLog(ctx, reqlog.Request{
+				ID:       reqLog.ID,
+				Response: &reqlog.Response{Response: res, Timestamp: time.Now()},
+			}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var reason string
+			if err := client.db.Get(&reason, `SELECT status_reason FROM http_responses WHERE req_id = ?`, reqLog.ID); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if reason != tt.expReason {
+				t.Errorf("expected stored reason %q, got: %q", tt.expReason, reason)
+			}
+
+			got, err := client.FindRequestLogByID(ctx, reqLog.ID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.Response.Response.Status != tt.expStatus {
+				t.Errorf("expected status %q, got: %q", tt.expStatus, got.Response.Response.Status)
+			}
+		})
+	}
+}
+
+func TestAddResponseLogTimings(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	dns := 5 * time.Millisecond
+	ttfb := 20 * time.Millisecond
+
+	tests := []struct {
+		name    string
+		timings reqlog.Timings
+	}{
+		{name: "recorded phases", timings: reqlog.Timings{DNS: &dns, TTFB: &ttfb}},
+		{name: "no phases", timings: reqlog.Timings{}},
+	}
+
+	for _, tt := range tests {
+		reqLog, err := client.AddRequestLog(ctx, reqlog.Request{
+			Request:   *httptest.NewRequest(http.MethodGet, "https://example.com/", nil),
+			Timestamp: time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("%v: could not add request log: %v", tt.name, err)
+		}
+
+		reqLog.Response = &reqlog.Response{
+			Response: http.Response{Status: "200 OK", StatusCode: http.StatusOK, Proto: "HTTP/1.1"},
+			Timings:  tt.timings,
+		}
+
+		if _, err := client.AddResponseLog(ctx, *reqLog); err != nil {
+			t.Fatalf("%v: could not add response log: %v", tt.name, err)
+		}
+
+		got, err := client.FindRequestLogByID(ctx, reqLog.ID)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", tt.name, err)
+		}
+
+		if !reflect.DeepEqual(got.Response.Timings, tt.timings) {
+			t.Errorf("%v: expected timings %+v, got: %+v", tt.name, tt.timings, got.Response.Timings)
+		}
+	}
+}
+
+func TestAddResponseLogRequestFields(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	reqLog, err := client.AddRequestLog(ctx, reqlog.Request{
+		Request:   *httptest.NewRequest(http.MethodGet, "https://example.com/", nil),
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("could not add request log: %v", err)
+	}
+
+	// The server address and client certificate are only known once the
+	// request was sent, so they're stored with the response log.
+	reqLog.ServerAddr = "93.184.216.34:443"
+	reqLog.ClientCert = true
+	reqLog.Response = &reqlog.Response{
+		Response: http.Response{Status: "200 OK", StatusCode: http.StatusOK, Proto: "HTTP/1.1"},
+	}
+
+	if _, err := client.AddResponseLog(ctx, *reqLog); err != nil {
+		t.Fatalf("could not add response log: %v", err)
+	}
+
+	got, err := client.FindRequestLogByID(ctx, reqLog.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.ServerAddr != reqLog.ServerAddr {
+		t.Errorf("expected server address %q, got: %q", reqLog.ServerAddr, got.ServerAddr)
+	}
+
+	if !got.ClientCert {
+		t.Error("expected request log to be flagged as sent with a client certificate")
+	}
+}
+
+func TestMaxDBSize(t *testing.T) {
+	t.Parallel()
+
+	const maxDBSize = 256 << 10
+
+	client, err := New(Config{
+		ProjectsPath:      t.TempDir(),
+		MaxDBSize:         maxDBSize,
+		SizeCheckInterval: 10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("could not create client: %v", err)
+	}
+
+	if err := client.OpenProject("test"); err != nil {
+		t.Fatalf("could not open project: %v", err)
+	}
+
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	body := []byte(strings.Repeat("a", 16<<10))
+	ts := time.Now()
+
+	var first, last *reqlog.Request
+
+	// Insert well past the limit, with increasing timestamps.
+	for i := 0; i < 64; i++ {
+		req := httptest.NewRequest(http.MethodPost, "https://example.com/", nil)
+
+		reqLog, err := client.AddRequestLog(ctx, reqlog.Request{
+			Request:   *req,
+			Body:      body,
+			Timestamp: ts.Add(time.Duration(i) * time.Second),
+		})
+		if err != nil {
+			t.Fatalf("could not add request log: %v", err)
+		}
+
+		if i == 0 {
+			first = reqLog
+		}
+
+		last = reqLog
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+
+	for {
+		_, err := client.FindRequestLogByID(ctx, first.ID)
+		if errors.Is(err, reqlog.ErrRequestNotFound) {
+			break
+		} else if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatal("expected oldest request log to be evicted")
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if _, err := client.FindRequestLogByID(ctx, last.ID); err != nil {
+		t.Fatalf("expected newest request log to be kept, got error: %v", err)
+	}
+
+	// Eviction runs in the background, so enforce the limit once more to make
+	// sure a pending check is done.
+	if _, err := client.enforceMaxDBSize(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	size, _, err := client.dbSize(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if size > maxDBSize {
+		t.Errorf("expected database size to be at most %v bytes, got: %v", maxDBSize, size)
+	}
+}
+
+func TestCheckIntegrity(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	addReqLog := func(rawURL string) int64 {
+		req := httptest.NewRequest(http.MethodGet, rawURL, nil)
+		req.Header.Set("X-Foo", "bar")
+
+		res := http.Response{
+			StatusCode: http.StatusOK,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     http.Header{"X-Bar": []string{"baz"}},
+		}
+
+		return addTestRequestLog(t, client, reqlog.Request{
+			Request:  *req,
+			Response: &reqlog.Response{Response: res},
+		}).ID
+	}
+
+	intact := addReqLog("https://example.com/intact")
+	orphaned := addReqLog("https://example.com/orphaned")
+
+	// Create orphans on a dedicated connection with foreign keys disabled, so
+	// the deletion isn't cascaded.
+	conn, err := client.db.Conn(ctx)
+	if err != nil {
+		t.Fatalf("could not get connection: %v", err)
+	}
+
+	for _, query := range []string{
+		"PRAGMA foreign_keys = OFF",
+		fmt.Sprintf("DELETE FROM http_requests WHERE id = %d", orphaned),
+		"INSERT INTO http_responses (req_id, status_code) VALUES (9999, 200)",
+		"PRAGMA foreign_keys = ON",
+	} {
+		if _, err := conn.ExecContext(ctx, query); err != nil {
+			t.Fatalf("could not execute %q: %v", query, err)
+		}
+	}
+
+	conn.Close()
+
+	report, err := client.CheckIntegrity(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if exp := (reqlog.IntegrityReport{OrphanResponses: 2, OrphanHeaders: 1}); report != exp {
+		t.Errorf("expected integrity report %+v, got: %+v", exp, report)
+	}
+
+	reqLogs, err := client.FindRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reqLogs) != 1 || reqLogs[0].ID != intact || reqLogs[0].Response == nil {
+		t.Fatalf("expected only request log %v with response, got: %+v", intact, reqLogs)
+	}
+
+	if got := reqLogs[0].Response.Response.Header.Get("X-Bar"); got != "baz" {
+		t.Errorf("expected response header `X-Bar: baz`, got: %q", got)
+	}
+
+	deleted, err := client.DeleteOrphans(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if exp := (reqlog.IntegrityReport{OrphanResponses: 2, OrphanHeaders: 1}); deleted != exp {
+		t.Errorf("expected deleted orphans %+v, got: %+v", exp, deleted)
+	}
+
+	report, err = client.CheckIntegrity(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !report.OK() {
+		t.Errorf("expected no orphans after repair, got: %+v", report)
+	}
+
+	var headerCount int
+	if err := client.db.Get(&headerCount, "SELECT COUNT(*) FROM http_headers"); err != nil {
+		t.Fatalf("could not count headers: %v", err)
+	}
+
+	if headerCount != 2 {
+		t.Errorf("expected 2 headers of intact request log, got: %v", headerCount)
+	}
+}
+
+func TestPruneOrphanHeaders(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+	req.Header.Set("X-Foo", "bar")
+
+	reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+	if err != nil {
+		t.Fatalf("could not add request log: %v", err)
+	}
+
+	res := http.Response{
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		Header:     http.Header{"X-Bar": []string{"baz"}},
+	}
+
+	if _, err := client.AddResponseLog(ctx, reqlog.Request{
+		ID:       reqLog.ID,
+		Response: &reqlog.Response{Response: res, Timestamp: time.Now()},
+	}); err != nil {
+		t.Fatalf("could not add response log: %v", err)
+	}
+
+	// Insert a header of a nonexistent response log, with foreign keys
+	// disabled, like a response log that was deleted without cascading.
+	conn, err := client.db.Conn(ctx)
+	if err != nil {
+		t.Fatalf("could not get connection: %v", err)
+	}
+
+	for _, query := range []string{
+		"PRAGMA foreign_keys = OFF",
+		"INSERT INTO http_headers (res_id, key, value) VALUES (9999, 'X-Orphan', 'foo')",
+		"PRAGMA foreign_keys = ON",
+	} {
+		if _, err := conn.ExecContext(ctx, query); err != nil {
+			t.Fatalf("could not execute %q: %v", query, err)
+		}
+	}
+
+	conn.Close()
+
+	n, err := client.PruneOrphanHeaders(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n != 1 {
+		t.Errorf("expected 1 deleted header, got: %v", n)
+	}
+
+	var keys []string
+	if err := client.db.Select(&keys, "SELECT key FROM http_headers ORDER BY id"); err != nil {
+		t.Fatalf("could not query headers: %v", err)
+	}
+
+	if exp := []string{"X-Foo", "X-Bar"}; !reflect.DeepEqual(keys, exp) {
+		t.Errorf("expected headers %v, got: %v", exp, keys)
+	}
+}
+
+func TestBodyLineCount(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		body []byte
+		exp  *int64
+	}{
+		{name: "multi-line body", body: []byte("{\n  \"foo\": \"bar\",\n  \"baz\": 42\n}"), exp: int64Ptr(4)},
+		{name: "trailing line feed", body: []byte("foo\r\nbar\n"), exp: int64Ptr(3)},
+		{name: "single line", body: []byte("foo"), exp: int64Ptr(1)},
+		{name: "empty body", body: nil, exp: int64Ptr(0)},
+		{name: "invalid UTF-8", body: []byte{0xff, 0xfe, '\n'}},
+		{name: "NUL byte", body: []byte("foo\x00\nbar")},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPost, "https://example.com/", nil)
+
+			reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Body: tt.body, Timestamp: time.Now()})
+			if err != nil {
+				t.Fatalf("could not add request log: %v", err)
+			}
+
+			res := http.Response{StatusCode: http.StatusOK, Proto: "HTTP/1.1", Header: http.Header{}}
+			if _, err := client.AddResponseLog(ctx, reqlog.Request{
+				ID:       reqLog.ID,
+				Response: &reqlog.Response{Response: res, Body: tt.body, Timestamp: time.Now()},
+			}); err != nil {
+				t.Fatalf("could not add response log: %v", err)
+			}
+
+			// Line counts are only computed when they're selected in a GraphQL
+			// query, so the columns are queried directly.
+			var dto httpRequest
+
+			err = client.db.GetContext(ctx, &dto, `SELECT res.id AS res_id, `+reqBodyLineCountCol+`, `+resBodyLineCountCol+`
+				FROM http_requests req JOIN http_responses res ON res.req_id = req.id
+				WHERE req.id = ?`, reqLog.ID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := dto.toRequestLog()
+
+			if !reflect.DeepEqual(got.BodyLineCount, tt.exp) {
+				t.Errorf("expected request body line count %v, got: %v",
+					formatInt64Ptr(tt.exp), formatInt64Ptr(got.BodyLineCount))
+			}
+
+			if !reflect.DeepEqual(got.Response.BodyLineCount, tt.exp) {
+				t.Errorf("expected response body line count %v, got: %v",
+					formatInt64Ptr(tt.exp), formatInt64Ptr(got.Response.BodyLineCount))
+			}
+
+			all, err := client.FindRequestLogByID(ctx, reqLog.ID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if all.BodyLineCount != nil || all.Response.BodyLineCount != nil {
+				t.Error("expected line counts not to be computed for a query of all fields")
+			}
+		})
+	}
+}
+
+func int64Ptr(n int64) *int64 {
+	return &n
+}
+
+func formatInt64Ptr(n *int64) string {
+	if n == nil {
+		return "<nil>"
+	}
+
+	return strconv.FormatInt(*n, 10)
+}
+
+func importEntries(n int) []reqlog.ImportEntry {
+	entries := make([]reqlog.ImportEntry, n)
+
+	for i := range entries {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("https://example.com/%v", i), nil)
+		req.Header.Set("X-Foo", "bar")
+
+		timestamp := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(i) * time.Second)
+
+		entries[i] = reqlog.ImportEntry{
+			Key: fmt.Sprintf("example.har#%v", i),
+			Request: reqlog.Request{
+				Request:   *req,
+				Timestamp: timestamp,
+				Response: &reqlog.Response{
+					Response: http.Response{
+						StatusCode: http.StatusOK,
+						Proto:      "HTTP/1.1",
+						Header:     http.Header{"Content-Type": []string{"text/plain"}},
+					},
+					Body:      []byte("foobar"),
+					Timestamp: timestamp.Add(50 * time.Millisecond),
+				},
+			},
+		}
+	}
+
+	return entries
+}
+
+func TestBulkImportRequestLogs(t *testing.T) {
+	t.Parallel()
+
+	// With a single connection, the connection used for the import is the one
+	// that's used afterwards.
+	client, err := New(Config{ProjectsPath: t.TempDir(), MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("could not create client: %v", err)
+	}
+
+	if err := client.OpenProject("test"); err != nil {
+		t.Fatalf("could not open project: %v", err)
+	}
+
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	entries := importEntries(100)
+
+	inserted, err := client.BulkImportRequestLogs(ctx, entries)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if inserted != len(entries) {
+		t.Errorf("expected %v inserted request logs, got: %v", len(entries), inserted)
+	}
+
+	// Entries that were imported before are skipped.
+	inserted, err = client.BulkImportRequestLogs(ctx, entries[:10])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if inserted != 0 {
+		t.Errorf("expected no inserted request logs, got: %v", inserted)
+	}
+
+	report, err := client.CheckIntegrity(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !report.OK() {
+		t.Errorf("expected no orphans, got: %+v", report)
+	}
+
+	for _, tt := range []struct {
+		table string
+		exp   int
+	}{
+		{table: "http_requests", exp: 100},
+		{table: "http_responses", exp: 100},
+		{table: "http_headers", exp: 200},
+	} {
+		var count int
+		if err := client.db.Get(&count, "SELECT COUNT(*) FROM "+tt.table); err != nil {
+			t.Fatalf("could not count rows: %v", err)
+		}
+
+		if count != tt.exp {
+			t.Errorf("expected %v rows in %v, got: %v", tt.exp, tt.table, count)
+		}
+	}
+
+	// Each response log belongs to the request log of its entry.
+	var matched int
+
+	err = client.db.Get(&matched, `SELECT COUNT(*) FROM http_requests req
+		JOIN http_responses res ON res.req_id = req.id
+		WHERE CAST(res.body AS TEXT) = 'foobar' AND res.timestamp > req.timestamp`)
+	if err != nil {
+		t.Fatalf("could not count response logs: %v", err)
+	}
+
+	if matched != len(entries) {
+		t.Errorf("expected %v request logs with their response log, got: %v", len(entries), matched)
+	}
+
+	var foreignKeys bool
+	if err := client.db.Get(&foreignKeys, "PRAGMA foreign_keys"); err != nil {
+		t.Fatalf("could not query foreign keys pragma: %v", err)
+	}
+
+	if !foreignKeys {
+		t.Error("expected foreign keys to be enabled after bulk import")
+	}
+}
+
+func BenchmarkImportRequestLogs(b *testing.B) {
+	entries := importEntries(10000)
+
+	b.Run("per entry", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			client := newBenchmarkClient(b)
+
+			for _, entry := range entries {
+				if _, _, err := client.ImportRequestLog(context.Background(), entry.Key, entry.Request); err != nil {
+					b.Fatalf("unexpected error: %v", err)
+				}
+			}
+		}
+	})
+
+	b.Run("bulk", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			client := newBenchmarkClient(b)
+
+			if _, err := client.BulkImportRequestLogs(context.Background(), entries); err != nil {
+				b.Fatalf("unexpected error: %v", err)
+			}
+		}
+	})
+}
+
+func newBenchmarkClient(b *testing.B) *Client {
+	b.Helper()
+	b.StopTimer()
+	defer b.StartTimer()
+
+	client, err := New(Config{ProjectsPath: b.TempDir()})
+	if err != nil {
+		b.Fatalf("could not create client: %v", err)
+	}
+
+	if err := client.OpenProject("test"); err != nil {
+		b.Fatalf("could not open project: %v", err)
+	}
+
+	b.Cleanup(func() { client.Close() })
+
+	return client
+}
+
+func TestBulkImportRequestLogsExistingOrphans(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	// Orphans that exist before the import don't fail it.
+	conn, err := client.db.Conn(ctx)
+	if err != nil {
+		t.Fatalf("could not get connection: %v", err)
+	}
+
+	for _, query := range []string{
+		"PRAGMA foreign_keys = OFF",
+		"INSERT INTO http_responses (req_id, status_code) VALUES (9999, 200)",
+		"PRAGMA foreign_keys = ON",
+	} {
+		if _, err := conn.ExecContext(ctx, query); err != nil {
+			t.Fatalf("could not execute %q: %v", query, err)
+		}
+	}
+
+	conn.Close()
+
+	entries := make([]reqlog.ImportEntry, 2)
+
+	for i := range entries {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("https://example.com/%v", i), nil)
+		req.Header.Set("X-Foo", "bar")
+
+		entries[i] = reqlog.ImportEntry{
+			Key: fmt.Sprintf("key-%v", i),
+			Request: reqlog.Request{
+				Request:   *req,
+				Timestamp: time.Now(),
+				Response: &reqlog.Response{
+					Response:  http.Response{StatusCode: http.StatusOK, Proto: "HTTP/1.1", Header: http.Header{}},
+					Timestamp: time.Now(),
+				},
+			},
+		}
+	}
+
+	n, err := client.BulkImportRequestLogs(ctx, entries)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n != len(entries) {
+		t.Errorf("expected %v inserted request logs, got: %v", len(entries), n)
+	}
+
+	report, err := client.CheckIntegrity(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.OrphanResponses != 1 || report.OrphanHeaders != 0 {
+		t.Errorf("expected only the existing orphan response, got: %+v", report)
+	}
+}
+
+func TestFindRequestLogsWithCorruptHeaders(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	var reqIDs []int64
+
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+		req.Header.Set("X-Foo", "bar")
+
+		reqLog, err := client.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+		if err != nil {
+			t.Fatalf("could not add request log: %v", err)
+		}
+
+		res := http.Response{
+			StatusCode: http.StatusOK,
+			Proto:      "HTTP/1.1",
+			Header:     http.Header{"X-Bar": []string{"baz"}},
+		}
+
+		if _, err := client.AddResponseLog(ctx, reqlog.Request{
+			ID:       reqLog.ID,
+			Response: &reqlog.Response{Response: res, Timestamp: time.Now()},
+		}); err != nil {
+			t.Fatalf("could not add response log: %v", err)
+		}
+
+		reqIDs = append(reqIDs, reqLog.ID)
+	}
+
+	// Corrupt the headers of the request and response of the second request
+	// log, so they can't be scanned.
+	if _, err := client.db.Exec(`UPDATE http_headers SET value = NULL
+		WHERE req_id = ? OR res_id = (SELECT id FROM http_responses WHERE req_id = ?)`,
+		reqIDs[1], reqIDs[1]); err != nil {
+		t.Fatalf("could not corrupt headers: %v", err)
+	}
+
+	reqLogs, err := client.FindRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reqLogs) != len(reqIDs) {
+		t.Fatalf("expected %v request logs, got: %v", len(reqIDs), len(reqLogs))
+	}
+
+	for _, reqLog := range reqLogs {
+		if reqLog.ID == reqIDs[1] {
+			if reqLog.HeadersErr == nil || reqLog.Response.HeadersErr == nil {
+				t.Errorf("expected headers errors for request log %v, got: %v, %v",
+					reqLog.ID, reqLog.HeadersErr, reqLog.Response.HeadersErr)
+			}
+
+			if len(reqLog.Request.Header) != 0 || len(reqLog.Response.Response.Header) != 0 {
+				t.Errorf("expected empty headers for request log %v", reqLog.ID)
+			}
+
+			continue
+		}
+
+		if reqLog.HeadersErr != nil || reqLog.Response.HeadersErr != nil {
+			t.Errorf("unexpected headers errors for request log %v: %v, %v",
+				reqLog.ID, reqLog.HeadersErr, reqLog.Response.HeadersErr)
+		}
+
+		if got := reqLog.Request.Header.Get("X-Foo"); got != "bar" {
+			t.Errorf("expected request header of request log %v, got: %q", reqLog.ID, got)
+		}
+
+		if got := reqLog.Response.Response.Header.Get("X-Bar"); got != "baz" {
+			t.Errorf("expected response header of request log %v, got: %q", reqLog.ID, got)
+		}
+	}
+}
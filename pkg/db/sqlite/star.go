@@ -0,0 +1,35 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// SetRequestLogStarred stars (or unstars) a request log.
+func (c *Client) SetRequestLogStarred(ctx context.Context, reqID int64, starred bool) error {
+	if c.db == nil {
+		return proj.ErrNoProject
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	result, err := c.execLogsUpdate(ctx, `UPDATE http_requests SET starred = ? WHERE id = ?`, starred, reqID)
+	if err != nil {
+		return fmt.Errorf("sqlite: could not update starred: %w", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("sqlite: could not get affected rows: %w", err)
+	}
+
+	if n == 0 {
+		return reqlog.ErrRequestNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+// FindDistinctStatusCodes returns the distinct response status codes of request
+// logs matching a filter, in ascending order. Request logs without a response
+// are ignored.
+func (c *Client) FindDistinctStatusCodes(
+	ctx context.Context,
+	filter reqlog.FindRequestsFilter,
+	scope *scope.Scope,
+) ([]int, error) {
+	if c.db == nil {
+		return nil, proj.ErrNoProject
+	}
+
+	reqQuery, err := findRequestLogsQuery(httpRequestLogsQuery{
+		requestCols:  []string{"res.status_code AS status_code"},
+		joinResponse: true,
+	}, filter, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	reqSQL, args, err := reqQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not parse query: %w", err)
+	}
+
+	statusCodes := []int{}
+
+	err = c.conn.SelectContext(ctx, &statusCodes, `SELECT DISTINCT status_code FROM (`+reqSQL+`)
+		WHERE status_code IS NOT NULL
+		ORDER BY status_code`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not query distinct status codes: %w", err)
+	}
+
+	return statusCodes, nil
+}
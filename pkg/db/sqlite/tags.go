@@ -0,0 +1,159 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+// matchingRequestIDsQuery returns a query for the IDs of all request logs
+// matching a filter, for use as a subquery.
+func matchingRequestIDsQuery(filter reqlog.FindRequestsFilter, scope *scope.Scope) (string, []interface{}, error) {
+	// The response is joined, because search expressions can match on
+	// response fields.
+	reqQuery, err := findRequestLogsQuery(httpRequestLogsQuery{
+		requestCols:  []string{"req.id"},
+		joinResponse: true,
+	}, filter, scope)
+	if err != nil {
+		return "", nil, err
+	}
+
+	sql, args, err := reqQuery.ToSql()
+	if err != nil {
+		return "", nil, fmt.Errorf("sqlite: could not parse query: %w", err)
+	}
+
+	return sql, args, nil
+}
+
+// TagRequestLogs adds a tag to all request logs matching a filter, creating
+// the tag if it doesn't exist yet. If color is not nil, it's set on the tag.
+// It returns the number of request logs that were tagged; request logs that
+// already had the tag aren't counted.
+func (c *Client) TagRequestLogs(
+	ctx context.Context,
+	filter reqlog.FindRequestsFilter,
+	scope *scope.Scope,
+	name string,
+	color *string,
+) (int64, error) {
+	if c.db == nil {
+		return 0, proj.ErrNoProject
+	}
+
+	reqIDsSQL, args, err := matchingRequestIDsQuery(filter, scope)
+	if err != nil {
+		return 0, err
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	tx, err := c.beginTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO tags (name, color) VALUES (?, ?)
+		ON CONFLICT (name) DO UPDATE SET color = IFNULL(excluded.color, color)`, name, color)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not upsert tag: %w", err)
+	}
+
+	var tagID int64
+
+	if err := tx.GetContext(ctx, &tagID, `SELECT id FROM tags WHERE name = ?`, name); err != nil {
+		return 0, fmt.Errorf("sqlite: could not query tag: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx,
+		`INSERT OR IGNORE INTO http_request_tags (req_id, tag_id) SELECT id, ? FROM (`+reqIDsSQL+`)`,
+		append([]interface{}{tagID}, args...)...)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not tag request logs: %w", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not get affected rows: %w", err)
+	}
+
+	if err := bumpLogsVersion(ctx, tx); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("sqlite: could not commit transaction: %w", err)
+	}
+
+	return n, nil
+}
+
+// UntagRequestLogs removes a tag from all request logs matching a filter. It
+// returns the number of request logs the tag was removed from.
+func (c *Client) UntagRequestLogs(
+	ctx context.Context,
+	filter reqlog.FindRequestsFilter,
+	scope *scope.Scope,
+	name string,
+) (int64, error) {
+	if c.db == nil {
+		return 0, proj.ErrNoProject
+	}
+
+	reqIDsSQL, args, err := matchingRequestIDsQuery(filter, scope)
+	if err != nil {
+		return 0, err
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	result, err := c.execLogsUpdate(ctx, `DELETE FROM http_request_tags
+		WHERE tag_id = (SELECT id FROM tags WHERE name = ?) AND req_id IN (`+reqIDsSQL+`)`,
+		append([]interface{}{name}, args...)...)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not untag request logs: %w", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("sqlite: could not get affected rows: %w", err)
+	}
+
+	return n, nil
+}
+
+// FindRequestLogTags returns the tags of a request log, ordered by name.
+func (c *Client) FindRequestLogTags(ctx context.Context, reqID int64) ([]reqlog.Tag, error) {
+	if c.db == nil {
+		return nil, proj.ErrNoProject
+	}
+
+	var dtos []struct {
+		Name  string         `db:"name"`
+		Color sql.NullString `db:"color"`
+	}
+
+	err := c.conn.SelectContext(ctx, &dtos, `SELECT t.name, t.color FROM tags t
+		JOIN http_request_tags rt ON rt.tag_id = t.id
+		WHERE rt.req_id = ?
+		ORDER BY t.name`, reqID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("sqlite: could not query tags: %w", err)
+	}
+
+	tags := make([]reqlog.Tag, len(dtos))
+	for i, dto := range dtos {
+		tags[i] = reqlog.Tag{Name: dto.Name, Color: dto.Color.String}
+	}
+
+	return tags, nil
+}
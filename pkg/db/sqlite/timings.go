@@ -0,0 +1,94 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// resTimings is the JSON representation of response timings, in nanoseconds.
+// Phases that didn't happen are omitted, so they're read back as nil.
+type resTimings struct {
+	DNS     *int64 `json:"dns,omitempty"`
+	Connect *int64 `json:"connect,omitempty"`
+	TLS     *int64 `json:"tls,omitempty"`
+	TTFB    *int64 `json:"ttfb,omitempty"`
+}
+
+// encodeTimings returns the JSON representation of timings, or NULL if no
+// phases were recorded.
+func encodeTimings(timings reqlog.Timings) (sql.NullString, error) {
+	if timings.IsZero() {
+		return sql.NullString{}, nil
+	}
+
+	b, err := json.Marshal(resTimings{
+		DNS:     durationToNanos(timings.DNS),
+		Connect: durationToNanos(timings.Connect),
+		TLS:     durationToNanos(timings.TLS),
+		TTFB:    durationToNanos(timings.TTFB),
+	})
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("sqlite: could not encode timings: %w", err)
+	}
+
+	return sql.NullString{String: string(b), Valid: true}, nil
+}
+
+// Scan implements sql.Scanner.
+func (t *resTimings) Scan(value interface{}) error {
+	if value == nil {
+		*t = resTimings{}
+		return nil
+	}
+
+	var b []byte
+
+	switch v := value.(type) {
+	case string:
+		b = []byte(v)
+	case []byte:
+		b = v
+	default:
+		return errors.New("sqlite: cannot scan non-string value")
+	}
+
+	if err := json.Unmarshal(b, t); err != nil {
+		return fmt.Errorf("sqlite: could not decode timings: %w", err)
+	}
+
+	return nil
+}
+
+func (t resTimings) toTimings() reqlog.Timings {
+	return reqlog.Timings{
+		DNS:     nanosToDuration(t.DNS),
+		Connect: nanosToDuration(t.Connect),
+		TLS:     nanosToDuration(t.TLS),
+		TTFB:    nanosToDuration(t.TTFB),
+	}
+}
+
+func durationToNanos(d *time.Duration) *int64 {
+	if d == nil {
+		return nil
+	}
+
+	n := d.Nanoseconds()
+
+	return &n
+}
+
+func nanosToDuration(n *int64) *time.Duration {
+	if n == nil {
+		return nil
+	}
+
+	d := time.Duration(*n)
+
+	return &d
+}
@@ -0,0 +1,119 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// dbConn is implemented by both *sqlx.DB and *sqlx.Tx, so queries can run on
+// either.
+type dbConn interface {
+	sqlx.ExtContext
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+type txConn interface {
+	dbConn
+	Commit() error
+	Rollback() error
+}
+
+// WithTx calls fn with a repository that runs all operations in a single
+// transaction. The transaction is committed if fn returns nil, and rolled back
+// otherwise. Writes outside of fn wait until the transaction is done. Calling
+// WithTx on the repository passed to fn uses a savepoint.
+func (c *Client) WithTx(ctx context.Context, fn func(reqlog.Repository) error) error {
+	if c.db == nil {
+		return proj.ErrNoProject
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	tx, err := c.beginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("sqlite: could not start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	txClient := &Client{
+		db:            c.db,
+		conn:          tx,
+		inTx:          true,
+		dbPath:        c.dbPath,
+		activeProject: c.activeProject,
+		config:        c.config,
+	}
+
+	if err := fn(txClient); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("sqlite: could not commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// beginTx starts a transaction, or a savepoint if the client is already in a
+// transaction.
+func (c *Client) beginTx(ctx context.Context) (txConn, error) {
+	if !c.inTx {
+		return c.db.BeginTxx(ctx, nil)
+	}
+
+	c.savepoints++
+	name := fmt.Sprintf("sp%d", c.savepoints)
+
+	if _, err := c.conn.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return nil, err
+	}
+
+	return &savepoint{dbConn: c.conn, name: name}, nil
+}
+
+// savepoint is a nested transaction.
+type savepoint struct {
+	dbConn
+	name string
+	done bool
+}
+
+func (sp *savepoint) Commit() error {
+	if sp.done {
+		return sql.ErrTxDone
+	}
+
+	sp.done = true
+
+	_, err := sp.Exec("RELEASE " + sp.name)
+
+	return err
+}
+
+func (sp *savepoint) Rollback() error {
+	if sp.done {
+		return sql.ErrTxDone
+	}
+
+	sp.done = true
+
+	if _, err := sp.Exec("ROLLBACK TO " + sp.name); err != nil {
+		return err
+	}
+
+	_, err := sp.Exec("RELEASE " + sp.name)
+
+	return err
+}
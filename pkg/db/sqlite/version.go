@@ -0,0 +1,83 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// FindLogsVersion returns the state of the stored request logs, which changes
+// whenever request logs or response logs are added, deleted or updated, or
+// request logs are marked as read.
+func (c *Client) FindLogsVersion(ctx context.Context) (reqlog.LogsVersion, error) {
+	if c.db == nil {
+		return reqlog.LogsVersion{}, proj.ErrNoProject
+	}
+
+	var dto struct {
+		MaxRequestID  int64 `db:"max_req_id"`
+		MaxResponseID int64 `db:"max_res_id"`
+		Count         int64 `db:"count"`
+		LastReadID    int64 `db:"last_read_id"`
+		Changes       int64 `db:"changes"`
+	}
+
+	err := c.conn.GetContext(ctx, &dto, `SELECT
+		(SELECT IFNULL(MAX(id), 0) FROM http_requests) AS max_req_id,
+		(SELECT IFNULL(MAX(id), 0) FROM http_responses) AS max_res_id,
+		(SELECT COUNT(*) FROM http_requests) AS count,
+		(SELECT IFNULL(MAX(last_read_id), 0) FROM read_marker) AS last_read_id,
+		(SELECT IFNULL(MAX(changes), 0) FROM logs_version) AS changes`)
+	if err != nil {
+		return reqlog.LogsVersion{}, fmt.Errorf("sqlite: could not query logs version: %w", err)
+	}
+
+	return reqlog.LogsVersion{
+		MaxRequestID:  dto.MaxRequestID,
+		MaxResponseID: dto.MaxResponseID,
+		Count:         dto.Count,
+		LastReadID:    dto.LastReadID,
+		Changes:       dto.Changes,
+	}, nil
+}
+
+// bumpLogsVersion increments the number of changes of the logs version, for
+// updates of existing request logs that FindLogsVersion can't otherwise detect.
+func bumpLogsVersion(ctx context.Context, conn dbConn) error {
+	_, err := conn.ExecContext(ctx, `INSERT INTO logs_version (id, changes) VALUES (1, 1)
+		ON CONFLICT (id) DO UPDATE SET changes = changes + 1`)
+	if err != nil {
+		return fmt.Errorf("sqlite: could not update logs version: %w", err)
+	}
+
+	return nil
+}
+
+// execLogsUpdate executes a statement that updates existing request logs, and
+// bumps the logs version in the same transaction. The caller must hold
+// c.writeMu.
+func (c *Client) execLogsUpdate(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	tx, err := c.beginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: could not start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bumpLogsVersion(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("sqlite: could not commit transaction: %w", err)
+	}
+
+	return result, nil
+}
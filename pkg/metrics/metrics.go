@@ -0,0 +1,142 @@
+// Package metrics instruments a database repository with Prometheus metrics.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/dstotijn/hetty/pkg/db"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// Repository wraps a db.Repository and records metrics for stored request
+// and response logs. All other methods are passed through unaltered.
+type Repository struct {
+	db.Repository
+
+	capturedRequests  prometheus.Counter
+	capturedResponses prometheus.Counter
+	storedBytes       *prometheus.CounterVec
+	insertDuration    *prometheus.HistogramVec
+	insertErrors      *prometheus.CounterVec
+}
+
+// NewRepository returns a repository that records metrics on reg.
+func NewRepository(repo db.Repository, reg prometheus.Registerer) (*Repository, error) {
+	r := &Repository{
+		Repository: repo,
+		capturedRequests: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "hetty_captured_requests_total",
+			Help: "Total number of stored request logs.",
+		}),
+		capturedResponses: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "hetty_captured_responses_total",
+			Help: "Total number of stored response logs.",
+		}),
+		storedBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "hetty_captured_body_bytes_total",
+			Help: "Total number of captured body bytes, by type (request or response).",
+		}, []string{"type"}),
+		insertDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "hetty_db_insert_duration_seconds",
+			Help:    "Duration of database inserts, by operation.",
+			Buckets: prometheus.ExponentialBuckets(0.0005, 2, 14),
+		}, []string{"op"}),
+		insertErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "hetty_db_insert_errors_total",
+			Help: "Total number of failed database inserts, by operation.",
+		}, []string{"op"}),
+	}
+
+	collectors := []prometheus.Collector{
+		r.capturedRequests,
+		r.capturedResponses,
+		r.storedBytes,
+		r.insertDuration,
+		r.insertErrors,
+	}
+
+	for _, c := range collectors {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// Handler returns an HTTP handler that exposes the metrics of gatherer in the
+// Prometheus text format.
+func Handler(gatherer prometheus.Gatherer) http.Handler {
+	return promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{})
+}
+
+func (r *Repository) AddRequestLog(ctx context.Context, reqLog reqlog.Request) (*reqlog.Request, error) {
+	defer r.observe("request", time.Now())
+
+	stored, err := r.Repository.AddRequestLog(ctx, reqLog)
+	if err != nil {
+		r.insertErrors.WithLabelValues("request").Inc()
+		return nil, err
+	}
+
+	r.capturedRequests.Inc()
+	r.storedBytes.WithLabelValues("request").Add(float64(len(stored.Body)))
+
+	return stored, nil
+}
+
+func (r *Repository) AddResponseLog(ctx context.Context, reqLog reqlog.Request) (*reqlog.Response, error) {
+	defer r.observe("response", time.Now())
+
+	resLog, err := r.Repository.AddResponseLog(ctx, reqLog)
+	if err != nil {
+		r.insertErrors.WithLabelValues("response").Inc()
+		return nil, err
+	}
+
+	r.capturedResponses.Inc()
+	r.storedBytes.WithLabelValues("response").Add(float64(len(resLog.Body)))
+
+	return resLog, nil
+}
+
+func (r *Repository) AddRequestResponse(ctx context.Context, reqLog reqlog.Request) (*reqlog.Request, error) {
+	defer r.observe("request_response", time.Now())
+
+	stored, err := r.Repository.AddRequestResponse(ctx, reqLog)
+	if err != nil {
+		r.insertErrors.WithLabelValues("request_response").Inc()
+		return nil, err
+	}
+
+	r.capturedRequests.Inc()
+	r.capturedResponses.Inc()
+	r.storedBytes.WithLabelValues("request").Add(float64(len(stored.Body)))
+	r.storedBytes.WithLabelValues("response").Add(float64(len(stored.Response.Body)))
+
+	return stored, nil
+}
+
+// WithTx calls fn with a repository that runs all operations in a single
+// transaction, like the wrapped repository does. The repository passed to fn
+// records metrics too.
+func (r *Repository) WithTx(ctx context.Context, fn func(reqlog.Repository) error) error {
+	return r.Repository.WithTx(ctx, func(tx reqlog.Repository) error {
+		if txRepo, ok := tx.(db.Repository); ok {
+			wrapped := *r
+			wrapped.Repository = txRepo
+			tx = &wrapped
+		}
+
+		return fn(tx)
+	})
+}
+
+func (r *Repository) observe(op string, start time.Time) {
+	r.insertDuration.WithLabelValues(op).Observe(time.Since(start).Seconds())
+}
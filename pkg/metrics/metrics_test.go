@@ -0,0 +1,184 @@
+package metrics_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/dstotijn/hetty/pkg/db/sqlite"
+	"github.com/dstotijn/hetty/pkg/metrics"
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+// newTestRepository returns a metrics repository for a database with an open
+// project, and the registry it records metrics on.
+func newTestRepository(t *testing.T) (*metrics.Repository, *prometheus.Registry, *proj.Service) {
+	t.Helper()
+
+	client, err := sqlite.New(sqlite.Config{ProjectsPath: t.TempDir()})
+	if err != nil {
+		t.Fatalf("could not create database client: %v", err)
+	}
+
+	reg := prometheus.NewRegistry()
+
+	repo, err := metrics.NewRepository(client, reg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	projService, err := proj.NewService(repo)
+	if err != nil {
+		t.Fatalf("could not create project service: %v", err)
+	}
+
+	if _, err := projService.Open(context.Background(), "test"); err != nil {
+		t.Fatalf("could not open project: %v", err)
+	}
+
+	t.Cleanup(func() { projService.Close() })
+
+	return repo, reg, projService
+}
+
+// gatherMetrics returns the values of counters, and the sample counts of
+// histograms, summed by metric name.
+func gatherMetrics(t *testing.T, reg *prometheus.Registry) map[string]float64 {
+	t.Helper()
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("could not gather metrics: %v", err)
+	}
+
+	got := make(map[string]float64)
+
+	for _, mf := range families {
+		for _, m := range mf.GetMetric() {
+			switch {
+			case m.GetCounter() != nil:
+				got[mf.GetName()] += m.GetCounter().GetValue()
+			case m.GetHistogram() != nil:
+				got[mf.GetName()] += float64(m.GetHistogram().GetSampleCount())
+			}
+		}
+	}
+
+	return got
+}
+
+func TestRepository(t *testing.T) {
+	t.Parallel()
+
+	repo, reg, _ := newTestRepository(t)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/", nil)
+
+	reqLog, err := repo.AddRequestLog(ctx, reqlog.Request{Request: *req, Body: []byte("foo"), Timestamp: time.Now()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res := http.Response{Status: "200 OK", StatusCode: http.StatusOK, Proto: "HTTP/1.1"}
+
+	if _, err := repo.AddResponseLog(ctx, reqlog.Request{
+		ID:       reqLog.ID,
+		Response: &reqlog.Response{Response: res, Body: []byte("foobar"), Timestamp: time.Now()},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A response for a nonexistent request fails the insert.
+	if _, err := repo.AddResponseLog(ctx, reqlog.Request{
+		ID:       1337,
+		Response: &reqlog.Response{Response: res, Timestamp: time.Now()},
+	}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	got := gatherMetrics(t, reg)
+
+	exp := map[string]float64{
+		"hetty_captured_requests_total":    1,
+		"hetty_captured_responses_total":   1,
+		"hetty_captured_body_bytes_total":  9,
+		"hetty_db_insert_duration_seconds": 3,
+		"hetty_db_insert_errors_total":     1,
+	}
+
+	for name, v := range exp {
+		if got[name] != v {
+			t.Errorf("expected metric %q to be %v, got: %v", name, v, got[name])
+		}
+	}
+}
+
+func TestRepositoryAsyncWrites(t *testing.T) {
+	t.Parallel()
+
+	repo, reg, projService := newTestRepository(t)
+	ctx := context.Background()
+
+	// Async writes store logs in batches, in a transaction of the repository.
+	svc := reqlog.NewService(reqlog.Config{
+		Scope:          scope.New(repo, projService),
+		ProjectService: projService,
+		Repository:     repo,
+		AsyncWrites:    true,
+	})
+
+	caCert, caKey, err := proxy.NewCA("Hetty", "Hetty CA", time.Hour)
+	if err != nil {
+		t.Fatalf("could not create CA: %v", err)
+	}
+
+	p, err := proxy.NewProxy(proxy.Config{CACert: caCert, CAKey: caKey})
+	if err != nil {
+		t.Fatalf("could not create proxy: %v", err)
+	}
+
+	p.UseRequestModifier(svc.RequestModifier)
+	p.UseResponseModifier(svc.ResponseModifier)
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("foobar"))
+	}))
+	defer target.Close()
+
+	for i := 0; i < 3; i++ {
+		rec := httptest.NewRecorder()
+		p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target.URL, nil))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %v, got: %v", http.StatusOK, rec.Code)
+		}
+	}
+
+	if err := svc.Shutdown(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := gatherMetrics(t, reg)
+
+	exp := map[string]float64{
+		"hetty_captured_requests_total":    3,
+		"hetty_captured_responses_total":   3,
+		"hetty_captured_body_bytes_total":  18,
+		"hetty_db_insert_duration_seconds": 6,
+		"hetty_db_insert_errors_total":     0,
+	}
+
+	for name, v := range exp {
+		if got[name] != v {
+			t.Errorf("expected metric %q to be %v, got: %v", name, v, got[name])
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// AuthRule injects credentials in outbound requests to matching hosts, e.g. to
+// test an authenticated API with a client that can't be configured. Either
+// Username (for HTTP Basic auth) or Token (for Bearer auth) must be set.
+type AuthRule struct {
+	// Hosts are patterns of the hosts the rule applies to, matched like
+	// `UpstreamProxyConfig.NoProxy`.
+	Hosts    []string `json:"hosts"`
+	Username string   `json:"username"`
+	Password string   `json:"password"`
+	Token    string   `json:"token"`
+	// Overwrite replaces an `Authorization` header that's already set on the
+	// request. By default, such requests are left untouched.
+	Overwrite bool `json:"overwrite"`
+}
+
+// LoadAuthRules reads auth rules from a JSON file, with an array of rules.
+func LoadAuthRules(file string) ([]AuthRule, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: could not read auth rules: %w", err)
+	}
+
+	var rules []AuthRule
+
+	if err := json.Unmarshal(b, &rules); err != nil {
+		return nil, fmt.Errorf("proxy: could not parse auth rules: %w", err)
+	}
+
+	if err := validateAuthRules(rules); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func validateAuthRules(rules []AuthRule) error {
+	for i, rule := range rules {
+		if len(rule.Hosts) == 0 {
+			return fmt.Errorf("proxy: auth rule %v has no hosts", i)
+		}
+
+		if (rule.Username == "") == (rule.Token == "") {
+			return fmt.Errorf("proxy: auth rule %v must have either a username or a token", i)
+		}
+	}
+
+	return nil
+}
+
+// injectAuth sets the `Authorization` header of req, using the first rule that
+// matches the request host.
+func injectAuth(req *http.Request, rules []AuthRule) {
+	for _, rule := range rules {
+		if !matchHost(req.URL.Host, rule.Hosts) {
+			continue
+		}
+
+		if req.Header.Get("Authorization") != "" && !rule.Overwrite {
+			return
+		}
+
+		if rule.Token != "" {
+			req.Header.Set("Authorization", "Bearer "+rule.Token)
+		} else {
+			req.SetBasicAuth(rule.Username, rule.Password)
+		}
+
+		return
+	}
+}
@@ -0,0 +1,129 @@
+package proxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestAuthRules(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan string, 1)
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer target.Close()
+
+	tests := []struct {
+		name       string
+		rule       AuthRule
+		authHeader string
+		expAuth    string
+	}{
+		{
+			name:    "bearer token for matching host",
+			rule:    AuthRule{Hosts: []string{"127.0.0.1"}, Token: "foobar"},
+			expAuth: "Bearer foobar",
+		},
+		{
+			name:    "basic auth for matching host",
+			rule:    AuthRule{Hosts: []string{"127.0.0.1"}, Username: "foo", Password: "bar"},
+			expAuth: "Basic Zm9vOmJhcg==",
+		},
+		{
+			name:    "other host",
+			rule:    AuthRule{Hosts: []string{".example.com"}, Token: "foobar"},
+			expAuth: "",
+		},
+		{
+			name:       "existing header",
+			rule:       AuthRule{Hosts: []string{"127.0.0.1"}, Token: "foobar"},
+			authHeader: "Bearer baz",
+			expAuth:    "Bearer baz",
+		},
+		{
+			name:       "overwrite existing header",
+			rule:       AuthRule{Hosts: []string{"127.0.0.1"}, Token: "foobar", Overwrite: true},
+			authHeader: "Bearer baz",
+			expAuth:    "Bearer foobar",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestProxy(t, Config{AuthRules: []AuthRule{tt.rule}})
+
+			// Stands in for logging: modifiers see the request as it's sent.
+			modified := make(chan string, 1)
+
+			p.UseRequestModifier(func(next RequestModifyFunc) RequestModifyFunc {
+				return func(req *http.Request) {
+					modified <- req.Header.Get("Authorization")
+					next(req)
+				}
+			})
+
+			proxySrv := httptest.NewServer(p)
+			defer proxySrv.Close()
+
+			proxyURL, err := url.Parse(proxySrv.URL)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)}}
+
+			req, err := http.NewRequest(http.MethodGet, target.URL, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+
+			res, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			res.Body.Close()
+
+			if got := <-received; got != tt.expAuth {
+				t.Errorf("expected target to receive `Authorization` header %q, got: %q", tt.expAuth, got)
+			}
+
+			if got := <-modified; got != tt.expAuth {
+				t.Errorf("expected modifier to see `Authorization` header %q, got: %q", tt.expAuth, got)
+			}
+		})
+	}
+}
+
+func TestValidateAuthRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		rule AuthRule
+	}{
+		{name: "no hosts", rule: AuthRule{Token: "foobar"}},
+		{name: "no credentials", rule: AuthRule{Hosts: []string{"example.com"}}},
+		{name: "username and token", rule: AuthRule{Hosts: []string{"example.com"}, Username: "foo", Token: "bar"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := validateAuthRules([]AuthRule{tt.rule}); err == nil {
+				t.Fatal("expected error, got nil")
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ type CertConfig struct {
 	caPriv crypto.PrivateKey
 	priv   *rsa.PrivateKey
 	keyID  []byte
+	cache  *certCache
 }
 
 // NewCertConfig creates a MITM config using the CA certificate and
@@ -54,29 +55,30 @@ func NewCertConfig(ca *x509.Certificate, caPrivKey crypto.PrivateKey) (*CertConf
 	h.Write(pkixPubKey)
 	keyID := h.Sum(nil)
 
+	cache, err := newCertCache(CertCacheConfig{})
+	if err != nil {
+		return nil, err
+	}
+
 	return &CertConfig{
 		ca:     ca,
 		caPriv: caPrivKey,
 		priv:   priv,
 		keyID:  keyID,
+		cache:  cache,
 	}, nil
 }
 
+var (
+	ErrCAKeyMismatch = errors.New("proxy: CA private key does not match certificate")
+	ErrNotCA         = errors.New("proxy: certificate is not a CA")
+)
+
 // LoadOrCreateCA loads an existing CA key pair from disk, or creates
 // a new keypair and saves to disk if certificate or key files don't exist.
-func LoadOrCreateCA(caKeyFile, caCertFile string) (*x509.Certificate, *rsa.PrivateKey, error) {
-	tlsCA, err := tls.LoadX509KeyPair(caCertFile, caKeyFile)
+func LoadOrCreateCA(caKeyFile, caCertFile string) (*x509.Certificate, crypto.PrivateKey, error) {
+	caCert, caKey, err := LoadCA(caCertFile, caKeyFile)
 	if err == nil {
-		caCert, err := x509.ParseCertificate(tlsCA.Certificate[0])
-		if err != nil {
-			return nil, nil, fmt.Errorf("proxy: could not parse CA: %w", err)
-		}
-
-		caKey, ok := tlsCA.PrivateKey.(*rsa.PrivateKey)
-		if !ok {
-			return nil, nil, errors.New("proxy: private key is not RSA")
-		}
-
 		return caCert, caKey, nil
 	}
 
@@ -85,26 +87,21 @@ func LoadOrCreateCA(caKeyFile, caCertFile string) (*x509.Certificate, *rsa.Priva
 	}
 
 	// Create directories for files if they don't exist yet.
-	keyDir, _ := filepath.Split(caKeyFile)
-	if keyDir != "" {
-		if _, err := os.Stat(keyDir); os.IsNotExist(err) {
-			if err := os.MkdirAll(keyDir, 0755); err != nil {
-				return nil, nil, fmt.Errorf("proxy: could not create directory for CA key: %w", err)
-			}
+	for _, file := range []string{caKeyFile, caCertFile} {
+		dir, _ := filepath.Split(file)
+		if dir == "" {
+			continue
 		}
-	}
 
-	keyDir, _ = filepath.Split(caCertFile)
-	if keyDir != "" {
-		if _, err := os.Stat("keyDir"); os.IsNotExist(err) {
-			if err := os.MkdirAll(keyDir, 0755); err != nil {
-				return nil, nil, fmt.Errorf("proxy: could not create directory for CA cert: %w", err)
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return nil, nil, fmt.Errorf("proxy: could not create directory for CA file: %w", err)
 			}
 		}
 	}
 
 	// Create new CA keypair.
-	caCert, caKey, err := NewCA("Hetty", "Hetty CA", 365*24*time.Hour)
+	newCACert, newCAKey, err := NewCA("Hetty", "Hetty CA", 365*24*time.Hour)
 	if err != nil {
 		return nil, nil, fmt.Errorf("proxy: could not generate new CA keypair: %w", err)
 	}
@@ -114,18 +111,20 @@ func LoadOrCreateCA(caKeyFile, caCertFile string) (*x509.Certificate, *rsa.Priva
 	if err != nil {
 		return nil, nil, fmt.Errorf("proxy: could not open cert file for writing: %w", err)
 	}
+	defer certOut.Close()
 
 	keyOut, err := os.OpenFile(caKeyFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
 	if err != nil {
 		return nil, nil, fmt.Errorf("proxy: could not open key file for writing: %w", err)
 	}
+	defer keyOut.Close()
 
 	// Write PEM blocks to CA certificate and key files.
-	if err := pem.Encode(certOut, &pem.Block{Type: "CERTIFICATE", Bytes: caCert.Raw}); err != nil {
+	if err := pem.Encode(certOut, &pem.Block{Type: "CERTIFICATE", Bytes: newCACert.Raw}); err != nil {
 		return nil, nil, fmt.Errorf("proxy: could not write CA certificate to disk: %w", err)
 	}
 
-	privBytes, err := x509.MarshalPKCS8PrivateKey(caKey)
+	privBytes, err := x509.MarshalPKCS8PrivateKey(newCAKey)
 	if err != nil {
 		return nil, nil, fmt.Errorf("proxy: could not convert private key to DER format: %w", err)
 	}
@@ -134,9 +133,83 @@ func LoadOrCreateCA(caKeyFile, caCertFile string) (*x509.Certificate, *rsa.Priva
 		return nil, nil, fmt.Errorf("proxy: could not write CA key to disk: %w", err)
 	}
 
+	return newCACert, newCAKey, nil
+}
+
+// LoadCA loads a CA certificate and private key from PEM encoded files, e.g.
+// to use an existing organization CA that clients already trust. It validates
+// that the private key belongs to the certificate, and that the certificate is
+// allowed to sign other certificates.
+func LoadCA(caCertFile, caKeyFile string) (*x509.Certificate, crypto.PrivateKey, error) {
+	certPEM, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyPEM, err := os.ReadFile(caKeyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certBlock, _ := pem.Decode(certPEM)
+	if certBlock == nil || certBlock.Type != "CERTIFICATE" {
+		return nil, nil, errors.New("proxy: could not decode CA certificate PEM block")
+	}
+
+	caCert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("proxy: could not parse CA certificate: %w", err)
+	}
+
+	keyBlock, _ := pem.Decode(keyPEM)
+	if keyBlock == nil {
+		return nil, nil, errors.New("proxy: could not decode CA private key PEM block")
+	}
+
+	caKey, err := parsePrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("proxy: could not parse CA private key: %w", err)
+	}
+
+	pub, ok := caCert.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !pub.Equal(caKey.Public()) {
+		return nil, nil, ErrCAKeyMismatch
+	}
+
+	if !caCert.BasicConstraintsValid || !caCert.IsCA {
+		return nil, nil, ErrNotCA
+	}
+
+	if caCert.KeyUsage != 0 && caCert.KeyUsage&x509.KeyUsageCertSign == 0 {
+		return nil, nil, fmt.Errorf("%w: missing key usage for signing certificates", ErrNotCA)
+	}
+
 	return caCert, caKey, nil
 }
 
+// parsePrivateKey parses a DER encoded PKCS #8, PKCS #1 (RSA) or SEC 1 (EC)
+// private key.
+func parsePrivateKey(der []byte) (crypto.Signer, error) {
+	if key, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type (%T)", key)
+		}
+
+		return signer, nil
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+
+	if key, err := x509.ParseECPrivateKey(der); err == nil {
+		return key, nil
+	}
+
+	return nil, errors.New("unsupported private key format")
+}
+
 // NewCA creates a new CA certificate and associated private key.
 func NewCA(name, organization string, validity time.Duration) (*x509.Certificate, *rsa.PrivateKey, error) {
 	priv, err := rsa.GenerateKey(rand.Reader, 2048)
@@ -210,6 +283,7 @@ func (c *CertConfig) TLSConfig() *tls.Config {
 	}
 }
 
+// cert returns a (cached) leaf certificate for hostname.
 func (c *CertConfig) cert(hostname string) (*tls.Certificate, error) {
 	// Remove the port if it exists.
 	host, _, err := net.SplitHostPort(hostname)
@@ -217,6 +291,12 @@ func (c *CertConfig) cert(hostname string) (*tls.Certificate, error) {
 		hostname = host
 	}
 
+	return c.cache.getOrCreate(hostname, func() (*tls.Certificate, error) {
+		return c.newCert(hostname)
+	})
+}
+
+func (c *CertConfig) newCert(hostname string) (*tls.Certificate, error) {
 	serial, err := rand.Int(rand.Reader, MaxSerialNumber)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,89 @@
+package proxy
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadCA(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	// Generate two CA key pairs on disk.
+	for _, name := range []string{"a", "b"} {
+		_, _, err := LoadOrCreateCA(filepath.Join(dir, name+"_key.pem"), filepath.Join(dir, name+"_cert.pem"))
+		if err != nil {
+			t.Fatalf("could not create CA: %v", err)
+		}
+	}
+
+	t.Run("valid key pair", func(t *testing.T) {
+		t.Parallel()
+
+		caCert, caKey, err := LoadCA(filepath.Join(dir, "a_cert.pem"), filepath.Join(dir, "a_key.pem"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, err := NewCertConfig(caCert, caKey); err != nil {
+			t.Fatalf("could not create cert config: %v", err)
+		}
+	})
+
+	t.Run("mismatched key pair", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := LoadCA(filepath.Join(dir, "a_cert.pem"), filepath.Join(dir, "b_key.pem"))
+		if !errors.Is(err, ErrCAKeyMismatch) {
+			t.Fatalf("expected error %v, got: %v", ErrCAKeyMismatch, err)
+		}
+	})
+
+	t.Run("certificate is not a CA", func(t *testing.T) {
+		t.Parallel()
+
+		caCert, caKey, err := LoadCA(filepath.Join(dir, "a_cert.pem"), filepath.Join(dir, "a_key.pem"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		certConfig, err := NewCertConfig(caCert, caKey)
+		if err != nil {
+			t.Fatalf("could not create cert config: %v", err)
+		}
+
+		leaf, err := certConfig.cert("example.com")
+		if err != nil {
+			t.Fatalf("could not create leaf certificate: %v", err)
+		}
+
+		leafDir := t.TempDir()
+		writePEM(t, filepath.Join(leafDir, "cert.pem"), "CERTIFICATE", leaf.Leaf.Raw)
+
+		keyDER, err := x509.MarshalPKCS8PrivateKey(certConfig.priv)
+		if err != nil {
+			t.Fatalf("could not marshal private key: %v", err)
+		}
+
+		writePEM(t, filepath.Join(leafDir, "key.pem"), "PRIVATE KEY", keyDER)
+
+		_, _, err = LoadCA(filepath.Join(leafDir, "cert.pem"), filepath.Join(leafDir, "key.pem"))
+		if !errors.Is(err, ErrNotCA) {
+			t.Fatalf("expected error %v, got: %v", ErrNotCA, err)
+		}
+	})
+}
+
+func writePEM(t *testing.T, file, blockType string, der []byte) {
+	t.Helper()
+
+	b := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := os.WriteFile(file, b, 0600); err != nil {
+		t.Fatalf("could not write PEM file: %v", err)
+	}
+}
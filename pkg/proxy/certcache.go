@@ -0,0 +1,127 @@
+package proxy
+
+import (
+	"crypto/tls"
+	"errors"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// Default leaf certificate cache settings.
+const (
+	DefaultCertCacheSize = 1024
+	DefaultCertCacheTTL  = time.Hour
+)
+
+// CertCacheConfig configures the in-memory cache of generated leaf
+// certificates. Zero values fall back to the defaults.
+type CertCacheConfig struct {
+	// Size is the maximum number of cached certificates. When full, the least
+	// recently used certificate is evicted.
+	Size int
+	// TTL is how long a cached certificate is reused before a new one is
+	// generated. Certificates are never reused past their expiry date.
+	TTL time.Duration
+}
+
+type certCacheEntry struct {
+	cert      *tls.Certificate
+	expiresAt time.Time
+}
+
+// certCache is an LRU cache of leaf certificates, keyed by hostname.
+type certCache struct {
+	lru *lru.Cache
+	ttl time.Duration
+	now func() time.Time
+
+	// mu guards the LRU cache and calls. It's not held while a certificate is
+	// generated, so handshakes for other hosts aren't blocked.
+	mu sync.Mutex
+	// calls are the in-flight certificate generations, keyed by hostname, so
+	// that concurrent handshakes for the same host don't each generate a
+	// certificate.
+	calls map[string]*certCall
+}
+
+// certCall is an in-flight certificate generation. Its result is set before
+// done is closed.
+type certCall struct {
+	done chan struct{}
+	cert *tls.Certificate
+	err  error
+}
+
+func newCertCache(cfg CertCacheConfig) (*certCache, error) {
+	if cfg.Size < 0 {
+		return nil, errors.New("proxy: cert cache size cannot be negative")
+	}
+
+	if cfg.Size == 0 {
+		cfg.Size = DefaultCertCacheSize
+	}
+
+	if cfg.TTL == 0 {
+		cfg.TTL = DefaultCertCacheTTL
+	}
+
+	cache, err := lru.New(cfg.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &certCache{
+		lru:   cache,
+		ttl:   cfg.TTL,
+		now:   time.Now,
+		calls: make(map[string]*certCall),
+	}, nil
+}
+
+// getOrCreate returns a cached certificate for hostname, or calls create and
+// caches the result when a certificate is missing or expired. Concurrent calls
+// for the same hostname wait for a single call of create.
+func (c *certCache) getOrCreate(hostname string, create func() (*tls.Certificate, error)) (*tls.Certificate, error) {
+	c.mu.Lock()
+
+	now := c.now()
+
+	if v, ok := c.lru.Get(hostname); ok {
+		entry := v.(certCacheEntry)
+		if now.Before(entry.expiresAt) {
+			c.mu.Unlock()
+			return entry.cert, nil
+		}
+	}
+
+	if call, ok := c.calls[hostname]; ok {
+		c.mu.Unlock()
+		<-call.done
+
+		return call.cert, call.err
+	}
+
+	call := &certCall{done: make(chan struct{})}
+	c.calls[hostname] = call
+	c.mu.Unlock()
+
+	call.cert, call.err = create()
+
+	c.mu.Lock()
+	if call.err == nil {
+		expiresAt := now.Add(c.ttl)
+		if call.cert.Leaf != nil && call.cert.Leaf.NotAfter.Before(expiresAt) {
+			expiresAt = call.cert.Leaf.NotAfter
+		}
+
+		c.lru.Add(hostname, certCacheEntry{cert: call.cert, expiresAt: expiresAt})
+	}
+	delete(c.calls, hostname)
+	c.mu.Unlock()
+
+	close(call.done)
+
+	return call.cert, call.err
+}
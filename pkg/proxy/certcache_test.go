@@ -0,0 +1,162 @@
+package proxy
+
+import (
+	"crypto/tls"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestCertConfig(t *testing.T) *CertConfig {
+	t.Helper()
+
+	caCert, caKey, err := NewCA("Hetty", "Hetty CA", time.Hour)
+	if err != nil {
+		t.Fatalf("could not create CA: %v", err)
+	}
+
+	certConfig, err := NewCertConfig(caCert, caKey)
+	if err != nil {
+		t.Fatalf("could not create cert config: %v", err)
+	}
+
+	return certConfig
+}
+
+func TestCertCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reuses certificate for same host", func(t *testing.T) {
+		t.Parallel()
+
+		certConfig := newTestCertConfig(t)
+		getCert := certConfig.TLSConfig().GetCertificate
+
+		var (
+			wg    sync.WaitGroup
+			certs = make([]*tls.Certificate, 10)
+		)
+
+		for i := range certs {
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+
+				cert, err := getCert(&tls.ClientHelloInfo{ServerName: "example.com"})
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				certs[i] = cert
+			}(i)
+		}
+
+		wg.Wait()
+
+		for _, cert := range certs[1:] {
+			if cert != certs[0] {
+				t.Fatal("expected all connections to reuse the same certificate")
+			}
+		}
+
+		other, err := getCert(&tls.ClientHelloInfo{ServerName: "example.org"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if other == certs[0] {
+			t.Error("expected a different certificate for a different host")
+		}
+	})
+
+	t.Run("regenerates expired certificate", func(t *testing.T) {
+		t.Parallel()
+
+		certConfig := newTestCertConfig(t)
+
+		now := time.Now()
+		certConfig.cache.now = func() time.Time { return now }
+
+		first, err := certConfig.cert("example.com")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		now = now.Add(DefaultCertCacheTTL + time.Second)
+
+		second, err := certConfig.cert("example.com")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if first == second {
+			t.Error("expected expired certificate to be regenerated")
+		}
+	})
+
+	t.Run("evicts least recently used certificate", func(t *testing.T) {
+		t.Parallel()
+
+		certConfig := newTestCertConfig(t)
+
+		cache, err := newCertCache(CertCacheConfig{Size: 1})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		certConfig.cache = cache
+
+		first, _ := certConfig.cert("a.example.com")
+		_, _ = certConfig.cert("b.example.com")
+
+		again, err := certConfig.cert("a.example.com")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if first == again {
+			t.Error("expected evicted certificate to be regenerated")
+		}
+	})
+	t.Run("generates certificates for other hosts concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		cache, err := newCertCache(CertCacheConfig{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		done := make(chan struct{})
+
+		go func() {
+			defer close(done)
+
+			_, _ = cache.getOrCreate("slow.example.com", func() (*tls.Certificate, error) {
+				close(started)
+				<-release
+				return &tls.Certificate{}, nil
+			})
+		}()
+
+		<-started
+
+		cert := &tls.Certificate{}
+
+		got, err := cache.getOrCreate("example.com", func() (*tls.Certificate, error) {
+			return cert, nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got != cert {
+			t.Error("expected certificate of other host")
+		}
+
+		close(release)
+		<-done
+	})
+}
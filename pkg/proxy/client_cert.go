@@ -0,0 +1,221 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+)
+
+// ClientCert is a client certificate that's presented to matching hosts when
+// they request one during the TLS handshake, for APIs that require mutual TLS.
+type ClientCert struct {
+	// Hosts are patterns of the hosts the certificate is used for, matched
+	// like `UpstreamProxyConfig.NoProxy`.
+	Hosts       []string
+	Certificate tls.Certificate
+}
+
+// LoadClientCerts reads client certificates from a JSON file, with an array of
+// objects with `hosts`, and the PEM encoded `certFile` and `keyFile`. Relative
+// filepaths are relative to the working directory. It returns an error if a
+// certificate doesn't match its private key.
+func LoadClientCerts(file string) ([]ClientCert, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: could not read client certificates: %w", err)
+	}
+
+	var entries []struct {
+		Hosts    []string `json:"hosts"`
+		CertFile string   `json:"certFile"`
+		KeyFile  string   `json:"keyFile"`
+	}
+
+	if err := json.Unmarshal(b, &entries); err != nil {
+		return nil, fmt.Errorf("proxy: could not parse client certificates: %w", err)
+	}
+
+	certs := make([]ClientCert, len(entries))
+
+	for i, entry := range entries {
+		if len(entry.Hosts) == 0 {
+			return nil, fmt.Errorf("proxy: client certificate %v has no hosts", i)
+		}
+
+		cert, err := tls.LoadX509KeyPair(entry.CertFile, entry.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("proxy: could not load client certificate %v: %w", i, err)
+		}
+
+		certs[i] = ClientCert{Hosts: entry.Hosts, Certificate: cert}
+	}
+
+	return certs, nil
+}
+
+const clientCertDialKey contextKey = 4
+
+// clientCertTransport sends HTTPS requests to hosts with a client certificate
+// using a transport that presents it. Other requests are sent using the base
+// transport.
+type clientCertTransport struct {
+	base       *http.Transport
+	certs      []ClientCert
+	transports []*http.Transport
+
+	// conns are the open connections of transports, by local address, so the
+	// connection that a request is sent on can be looked up.
+	mu    sync.Mutex
+	conns map[string]*clientCertConn
+}
+
+// clientCertConn is a connection of a transport with a client certificate.
+// Whether the certificate was presented is only known after the TLS handshake,
+// because it's only presented if the host requests one.
+type clientCertConn struct {
+	net.Conn
+	t         *clientCertTransport
+	presented bool
+}
+
+// clientCertDial holds the connection that's dialed for a request, so it can be
+// flagged if a client certificate is presented during its TLS handshake.
+type clientCertDial struct {
+	conn *clientCertConn
+}
+
+// NewClientCertTransport returns a transport that presents the first matching
+// client certificate on TLS connections to a host, and otherwise behaves like
+// base. Requests that are sent on a connection that the certificate was
+// presented on are reported by ClientCertUsed, if they're traced with
+// TraceRequest.
+func NewClientCertTransport(base *http.Transport, certs []ClientCert) http.RoundTripper {
+	t := &clientCertTransport{
+		base:       base,
+		certs:      certs,
+		transports: make([]*http.Transport, len(certs)),
+		conns:      make(map[string]*clientCertConn),
+	}
+
+	dial := base.DialContext
+	if dial == nil {
+		dial = (&net.Dialer{}).DialContext
+	}
+
+	for i := range certs {
+		cert := certs[i].Certificate
+
+		transport := base.Clone()
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+
+		transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			conn, err := dial(ctx, network, addr)
+			if err != nil {
+				return nil, err
+			}
+
+			return t.addConn(ctx, conn), nil
+		}
+		transport.TLSClientConfig.GetClientCertificate = func(info *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			// Like with `tls.Config.Certificates`, no certificate is sent if
+			// the host doesn't accept it.
+			if err := info.SupportsCertificate(&cert); err != nil {
+				return &tls.Certificate{}, nil
+			}
+
+			if d, ok := info.Context().Value(clientCertDialKey).(*clientCertDial); ok {
+				t.mu.Lock()
+				if d.conn != nil {
+					d.conn.presented = true
+				}
+				t.mu.Unlock()
+			}
+
+			return &cert, nil
+		}
+
+		t.transports[i] = transport
+	}
+
+	return t
+}
+
+func (t *clientCertTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Scheme != "https" {
+		return t.base.RoundTrip(req)
+	}
+
+	for i, cert := range t.certs {
+		if !matchHost(req.URL.Host, cert.Hosts) {
+			continue
+		}
+
+		return t.transports[i].RoundTrip(t.traceRequest(req))
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+// traceRequest returns a copy of req that holds the connection dialed for it,
+// and that's flagged in its connection trace (if any) when it's sent on a
+// connection that a client certificate was presented on. The connection may
+// have been dialed for another request.
+func (t *clientCertTransport) traceRequest(req *http.Request) *http.Request {
+	ctx := context.WithValue(req.Context(), clientCertDialKey, &clientCertDial{})
+
+	if ct, ok := req.Context().Value(connTraceKey).(*connTrace); ok {
+		ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+			GotConn: func(info httptrace.GotConnInfo) {
+				t.mu.Lock()
+				conn, ok := t.conns[info.Conn.LocalAddr().String()]
+				presented := ok && conn.presented
+				t.mu.Unlock()
+
+				if presented {
+					ct.mu.Lock()
+					ct.clientCert = true
+					ct.mu.Unlock()
+				}
+			},
+		})
+	}
+
+	return req.WithContext(ctx)
+}
+
+// addConn tracks a dialed connection until it's closed, and sets it as the
+// connection dialed for the request of ctx.
+func (t *clientCertTransport) addConn(ctx context.Context, conn net.Conn) *clientCertConn {
+	cc := &clientCertConn{Conn: conn, t: t}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.conns[conn.LocalAddr().String()] = cc
+
+	if d, ok := ctx.Value(clientCertDialKey).(*clientCertDial); ok {
+		d.conn = cc
+	}
+
+	return cc
+}
+
+func (c *clientCertConn) Close() error {
+	key := c.LocalAddr().String()
+
+	c.t.mu.Lock()
+	if c.t.conns[key] == c {
+		delete(c.t.conns, key)
+	}
+	c.t.mu.Unlock()
+
+	return c.Conn.Close()
+}
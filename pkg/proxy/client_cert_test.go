@@ -0,0 +1,199 @@
+package proxy
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeClientCert writes a self-signed client certificate and its private key
+// to dir, and returns the certificate.
+func writeClientCert(t *testing.T, dir string) *x509.Certificate {
+	t.Helper()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("could not generate private key: %v", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "hetty-client"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, priv.Public(), priv)
+	if err != nil {
+		t.Fatalf("could not create certificate: %v", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("could not parse certificate: %v", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatalf("could not marshal private key: %v", err)
+	}
+
+	writePEM(t, filepath.Join(dir, "cert.pem"), "CERTIFICATE", der)
+	writePEM(t, filepath.Join(dir, "key.pem"), "PRIVATE KEY", keyDER)
+
+	return cert
+}
+
+func TestClientCertTransport(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	clientCert := writeClientCert(t, dir)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+
+	tests := []struct {
+		name          string
+		hosts         []string
+		clientAuth    tls.ClientAuthType
+		expErr        bool
+		expClientCert bool
+	}{
+		{
+			name:          "configured host",
+			hosts:         []string{"127.0.0.1"},
+			clientAuth:    tls.RequireAndVerifyClientCert,
+			expClientCert: true,
+		},
+		{
+			name:       "other host",
+			hosts:      []string{".example.com"},
+			clientAuth: tls.RequireAndVerifyClientCert,
+			expErr:     true,
+		},
+		{
+			name:       "host doesn't request certificate",
+			hosts:      []string{"127.0.0.1"},
+			clientAuth: tls.NoClientCert,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			peers := make(chan string, 2)
+
+			target := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				peer := ""
+				if len(r.TLS.PeerCertificates) > 0 {
+					peer = r.TLS.PeerCertificates[0].Subject.CommonName
+				}
+
+				peers <- peer
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			target.TLS = &tls.Config{
+				ClientAuth: tt.clientAuth,
+				ClientCAs:  clientCAs,
+			}
+			target.StartTLS()
+			defer target.Close()
+
+			rulesFile := filepath.Join(t.TempDir(), "client_certs.json")
+			rules := fmt.Sprintf(`[{"hosts": [%q], "certFile": %q, "keyFile": %q}]`,
+				tt.hosts[0], filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem"))
+
+			if err := os.WriteFile(rulesFile, []byte(rules), 0600); err != nil {
+				t.Fatalf("could not write client certificates: %v", err)
+			}
+
+			certs, err := LoadClientCerts(rulesFile)
+			if err != nil {
+				t.Fatalf("could not load client certificates: %v", err)
+			}
+
+			// The base transport trusts the certificate of the target.
+			base := target.Client().Transport.(*http.Transport).Clone()
+			client := &http.Client{Transport: NewClientCertTransport(base, certs)}
+
+			// The second request is sent on the connection of the first.
+			for i := 0; i < 2; i++ {
+				req, err := http.NewRequest(http.MethodGet, target.URL, nil)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				req = TraceRequest(req)
+
+				res, err := client.Do(req)
+				if tt.expErr {
+					if err == nil {
+						res.Body.Close()
+						t.Fatal("expected request without client certificate to fail")
+					}
+
+					if ClientCertUsed(req.Context()) {
+						t.Error("expected client certificate not to be used")
+					}
+
+					return
+				}
+
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				res.Body.Close()
+
+				expPeer := ""
+				if tt.expClientCert {
+					expPeer = "hetty-client"
+				}
+
+				if got := <-peers; got != expPeer {
+					t.Errorf("request %v: expected target to receive client certificate %q, got: %q", i+1, expPeer, got)
+				}
+
+				if got := ClientCertUsed(req.Context()); got != tt.expClientCert {
+					t.Errorf("request %v: expected client certificate used: %v, got: %v", i+1, tt.expClientCert, got)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadClientCertsMismatchedKey(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	otherDir := t.TempDir()
+
+	writeClientCert(t, dir)
+	writeClientCert(t, otherDir)
+
+	rulesFile := filepath.Join(dir, "client_certs.json")
+	rules := fmt.Sprintf(`[{"hosts": ["example.com"], "certFile": %q, "keyFile": %q}]`,
+		filepath.Join(dir, "cert.pem"), filepath.Join(otherDir, "key.pem"))
+
+	if err := os.WriteFile(rulesFile, []byte(rules), 0600); err != nil {
+		t.Fatalf("could not write client certificates: %v", err)
+	}
+
+	if _, err := LoadClientCerts(rulesFile); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
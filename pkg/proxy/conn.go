@@ -0,0 +1,83 @@
+package proxy
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"sync/atomic"
+)
+
+const (
+	connIDKey   contextKey = 2
+	listenerKey contextKey = 3
+)
+
+// ConnContext assigns a new connection ID to ctx. It's meant to be used as
+// `http.Server.ConnContext` of the server that the proxy handles requests of,
+// so that requests sent on the same (keep-alive) client connection can be
+// correlated, via ConnID. It also records the listener the connection was
+// accepted on, for retrieval via Listener.
+func (p *Proxy) ConnContext(ctx context.Context, conn net.Conn) context.Context {
+	ctx = withListener(ctx, listenerAddr(ctx, conn))
+
+	return withConnID(ctx, atomic.AddInt64(&p.connSeq, 1))
+}
+
+// maxConnSeqStart is the upper bound of the first connection ID of a proxy. It
+// leaves room for incrementing it, while all IDs stay below 2^53, so they can
+// be represented exactly in JavaScript clients of the API.
+const maxConnSeqStart = 1 << 52
+
+// randomConnSeq returns a random start value for the connection IDs of a proxy.
+func randomConnSeq() (int64, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(maxConnSeqStart))
+	if err != nil {
+		return 0, fmt.Errorf("proxy: could not generate connection ID: %w", err)
+	}
+
+	return n.Int64(), nil
+}
+
+func withConnID(ctx context.Context, id int64) context.Context {
+	return context.WithValue(ctx, connIDKey, id)
+}
+
+// ConnID returns the ID of the client connection that the request of ctx was
+// received on, or 0 if it isn't known. Requests received via a CONNECT tunnel
+// have the ID of the connection the tunnel was established on.
+func ConnID(ctx context.Context) int64 {
+	id, _ := ctx.Value(connIDKey).(int64)
+	return id
+}
+
+func withListener(ctx context.Context, listener string) context.Context {
+	return context.WithValue(ctx, listenerKey, listener)
+}
+
+// Listener returns the address of the listener (e.g. ":8080") that the client
+// connection of the request of ctx was accepted on, or an empty string if it
+// isn't known. With multiple proxy listeners, it identifies the one that
+// captured the request. Requests received via a CONNECT tunnel have the
+// listener of the CONNECT request.
+func Listener(ctx context.Context) string {
+	listener, _ := ctx.Value(listenerKey).(string)
+	return listener
+}
+
+// listenerAddr returns the configured address of the server that accepted
+// conn, or the local address of conn if the server has no address (e.g. when
+// it serves a listener that was created separately).
+func listenerAddr(ctx context.Context, conn net.Conn) string {
+	if srv, ok := ctx.Value(http.ServerContextKey).(*http.Server); ok && srv.Addr != "" {
+		return srv.Addr
+	}
+
+	if conn != nil && conn.LocalAddr() != nil {
+		return conn.LocalAddr().String()
+	}
+
+	return ""
+}
@@ -0,0 +1,28 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+)
+
+func TestConnContext(t *testing.T) {
+	t.Parallel()
+
+	// Each proxy stands in for a run of the proxy, logging to the same project.
+	first := newTestProxy(t, Config{})
+	second := newTestProxy(t, Config{})
+
+	ctx := context.Background()
+
+	a := ConnID(first.ConnContext(ctx, nil))
+	b := ConnID(first.ConnContext(ctx, nil))
+	c := ConnID(second.ConnContext(ctx, nil))
+
+	if a == 0 || b != a+1 {
+		t.Errorf("expected consecutive connection IDs, got: %v and %v", a, b)
+	}
+
+	if c == a || c == b {
+		t.Errorf("expected connection ID of other proxy to differ from %v and %v, got: %v", a, b, c)
+	}
+}
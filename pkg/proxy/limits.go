@@ -0,0 +1,109 @@
+package proxy
+
+import (
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// LimitsConfig limits forwarded requests, to guard against upstreams that hang
+// or stream endlessly.
+type LimitsConfig struct {
+	// Timeout is the maximum duration of a forwarded request, from sending
+	// the request until the response body is read. Zero means no timeout.
+	Timeout time.Duration
+	// MaxResponseSize is the maximum number of bytes of a response body that
+	// are forwarded. The rest of the body is cut off. Zero means unlimited.
+	MaxResponseSize int64
+	// PerHost overrides the limits for specific hostnames, without port. Zero
+	// values fall back to the limits above.
+	PerHost map[string]HostLimits
+}
+
+// HostLimits are the limits of requests to a host.
+type HostLimits struct {
+	Timeout         time.Duration
+	MaxResponseSize int64
+}
+
+func newLimits(cfg LimitsConfig) LimitsConfig {
+	perHost := make(map[string]HostLimits, len(cfg.PerHost))
+	for host, limits := range cfg.PerHost {
+		perHost[strings.ToLower(host)] = limits
+	}
+
+	cfg.PerHost = perHost
+
+	return cfg
+}
+
+// forHost returns the limits of requests to host, which may include a port.
+func (cfg LimitsConfig) forHost(host string) HostLimits {
+	limits := HostLimits{
+		Timeout:         cfg.Timeout,
+		MaxResponseSize: cfg.MaxResponseSize,
+	}
+
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	override, ok := cfg.PerHost[strings.ToLower(host)]
+	if !ok {
+		return limits
+	}
+
+	if override.Timeout > 0 {
+		limits.Timeout = override.Timeout
+	}
+
+	if override.MaxResponseSize > 0 {
+		limits.MaxResponseSize = override.MaxResponseSize
+	}
+
+	return limits
+}
+
+// cappedBody is a response body that ends after a maximum number of bytes.
+type cappedBody struct {
+	io.ReadCloser
+	remaining int64
+	capped    bool
+}
+
+func (b *cappedBody) Read(p []byte) (int, error) {
+	if b.remaining <= 0 {
+		if b.capped {
+			return 0, io.EOF
+		}
+
+		// Only a body that has more bytes than the maximum is capped.
+		var probe [1]byte
+
+		n, err := b.ReadCloser.Read(probe[:])
+		if n > 0 {
+			b.capped = true
+			return 0, io.EOF
+		}
+
+		return 0, err
+	}
+
+	if int64(len(p)) > b.remaining {
+		p = p[:b.remaining]
+	}
+
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+
+	return n, err
+}
+
+// BodyCapped reports whether body is a response body that was cut off at the
+// maximum response size. It's only known once the body was read up to the
+// maximum.
+func BodyCapped(body io.Reader) bool {
+	b, ok := body.(*cappedBody)
+	return ok && b.capped
+}
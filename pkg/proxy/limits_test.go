@@ -0,0 +1,130 @@
+package proxy
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLimits(t *testing.T) {
+	t.Parallel()
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			select {
+			case <-time.After(5 * time.Second):
+			case <-r.Context().Done():
+			}
+		}
+
+		w.Write([]byte(strings.Repeat("a", 100)))
+	}))
+	defer target.Close()
+
+	tests := []struct {
+		name       string
+		limits     LimitsConfig
+		path       string
+		expStatus  int
+		expBodyLen int
+	}{
+		{
+			name: "slow host hits its timeout",
+			limits: LimitsConfig{
+				Timeout: time.Minute,
+				PerHost: map[string]HostLimits{"127.0.0.1": {Timeout: 50 * time.Millisecond}},
+			},
+			path:      "/slow",
+			expStatus: http.StatusGatewayTimeout,
+		},
+		{
+			name: "response within timeout",
+			limits: LimitsConfig{
+				PerHost: map[string]HostLimits{"127.0.0.1": {Timeout: time.Minute}},
+			},
+			path:       "/",
+			expStatus:  http.StatusOK,
+			expBodyLen: 100,
+		},
+		{
+			name: "response exceeds size cap",
+			limits: LimitsConfig{
+				MaxResponseSize: 1000,
+				PerHost:         map[string]HostLimits{"127.0.0.1": {MaxResponseSize: 10}},
+			},
+			path:       "/",
+			expStatus:  http.StatusOK,
+			expBodyLen: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestProxy(t, Config{Limits: tt.limits})
+
+			capped := make(chan bool, 1)
+
+			// Stands in for logging, which reads the body before it's forwarded.
+			p.UseResponseModifier(func(next ResponseModifyFunc) ResponseModifyFunc {
+				return func(res *http.Response) error {
+					body, err := ioutil.ReadAll(res.Body)
+					if err != nil {
+						return err
+					}
+
+					capped <- BodyCapped(res.Body)
+					res.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+					return next(res)
+				}
+			})
+
+			proxySrv := httptest.NewServer(p)
+			defer proxySrv.Close()
+
+			proxyURL, err := url.Parse(proxySrv.URL)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)}}
+
+			res, err := client.Get(target.URL + tt.path)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer res.Body.Close()
+
+			if res.StatusCode != tt.expStatus {
+				t.Fatalf("expected status code %v, got: %v", tt.expStatus, res.StatusCode)
+			}
+
+			if tt.expStatus != http.StatusOK {
+				return
+			}
+
+			expCapped := tt.expBodyLen < 100
+
+			// A capped body is cut off, so it's shorter than its content length.
+			body, err := ioutil.ReadAll(res.Body)
+			if err != nil && !(expCapped && errors.Is(err, io.ErrUnexpectedEOF)) {
+				t.Fatalf("could not read response body: %v", err)
+			}
+
+			if len(body) != tt.expBodyLen {
+				t.Errorf("expected body length %v, got: %v", tt.expBodyLen, len(body))
+			}
+
+			if <-capped != expCapped {
+				t.Errorf("expected body capped to be %v", expCapped)
+			}
+		})
+	}
+}
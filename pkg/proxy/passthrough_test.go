@@ -0,0 +1,90 @@
+package proxy
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+)
+
+func TestPassThroughHosts(t *testing.T) {
+	t.Parallel()
+
+	target := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer target.Close()
+
+	targetTLSConfig := target.Client().Transport.(*http.Transport).TLSClientConfig
+
+	tests := []struct {
+		name             string
+		passThroughHosts []string
+		expTunneled      bool
+	}{
+		{name: "pass-through host", passThroughHosts: []string{"127.0.0.1"}, expTunneled: true},
+		{name: "other host", passThroughHosts: []string{".example.com"}, expTunneled: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestProxy(t, Config{PassThroughHosts: tt.passThroughHosts})
+
+			// Stands in for logging: modifiers are called for intercepted requests.
+			var modified int32
+
+			p.UseRequestModifier(func(next RequestModifyFunc) RequestModifyFunc {
+				return func(req *http.Request) {
+					atomic.AddInt32(&modified, 1)
+					next(req)
+				}
+			})
+			p.UseResponseModifier(func(next ResponseModifyFunc) ResponseModifyFunc {
+				return func(res *http.Response) error {
+					atomic.AddInt32(&modified, 1)
+					return next(res)
+				}
+			})
+
+			proxySrv := httptest.NewServer(p)
+			defer proxySrv.Close()
+
+			proxyURL, err := url.Parse(proxySrv.URL)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// The client only trusts the certificate of the target, so the
+			// request only succeeds if TLS isn't intercepted by the proxy.
+			client := &http.Client{Transport: &http.Transport{
+				Proxy:           http.ProxyURL(proxyURL),
+				TLSClientConfig: &tls.Config{RootCAs: targetTLSConfig.RootCAs},
+			}}
+
+			res, err := client.Get(target.URL)
+			if !tt.expTunneled {
+				if err == nil {
+					res.Body.Close()
+					t.Fatal("expected intercepted TLS connection to fail")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer res.Body.Close()
+
+			if res.StatusCode != http.StatusNoContent {
+				t.Errorf("expected status %v, got: %v", http.StatusNoContent, res.StatusCode)
+			}
+
+			if n := atomic.LoadInt32(&modified); n != 0 {
+				t.Errorf("expected modifiers not to be called, got %v calls", n)
+			}
+		})
+	}
+}
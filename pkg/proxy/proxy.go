@@ -7,10 +7,13 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net"
 	"net/http"
 	"net/http/httputil"
+	"sync/atomic"
+	"time"
 )
 
 type contextKey int
@@ -20,45 +23,149 @@ const ReqIDKey contextKey = 0
 // Proxy implements http.Handler and offers MITM behaviour for modifying
 // HTTP requests and responses.
 type Proxy struct {
-	certConfig *CertConfig
-	handler    http.Handler
+	certConfig  *CertConfig
+	handler     http.Handler
+	transport   http.RoundTripper
+	rateLimiter *rateLimiter
+	limits      LimitsConfig
+
+	passThroughHosts []string
+	authRules        []AuthRule
+
+	// connSeq is the last assigned connection ID. It's accessed atomically.
+	// It starts at a random value, so that IDs assigned by different runs of
+	// the proxy don't collide in the same project.
+	connSeq int64
 
 	// TODO: Add mutex for modifier funcs.
 	reqModifiers []RequestModifyMiddleware
 	resModifiers []ResponseModifyMiddleware
 }
 
+// Config is used to configure a Proxy.
+type Config struct {
+	CACert *x509.Certificate
+	CAKey  crypto.PrivateKey
+
+	// CertCache configures caching of generated leaf certificates.
+	CertCache CertCacheConfig
+
+	// RateLimit is optional, and limits the rate of outbound requests.
+	RateLimit RateLimitConfig
+
+	// Limits is optional, and limits the duration and response size of
+	// forwarded requests.
+	Limits LimitsConfig
+
+	// UpstreamProxy is optional. When nil, the proxy settings from the
+	// environment (e.g. `HTTP_PROXY`) are used.
+	UpstreamProxy *UpstreamProxyConfig
+
+	// PassThroughHosts is a list of hosts that aren't intercepted. CONNECT
+	// requests for these hosts are tunneled to the host as is, so TLS isn't
+	// decrypted, and plain HTTP requests are forwarded without calling request
+	// and response modifiers (so they aren't logged either). Tunnels are always
+	// dialed directly, regardless of UpstreamProxy. Patterns are matched like
+	// `UpstreamProxyConfig.NoProxy`.
+	PassThroughHosts []string
+
+	// ClientCerts are optional, and are presented to matching hosts that
+	// request a client certificate, for mutual TLS.
+	ClientCerts []ClientCert
+
+	// AuthRules are optional, and inject an `Authorization` header in outbound
+	// requests to matching hosts. The first matching rule is used. Because
+	// they're applied before request modifiers, logged requests include the
+	// header.
+	AuthRules []AuthRule
+}
+
 // NewProxy returns a new Proxy.
-func NewProxy(ca *x509.Certificate, key crypto.PrivateKey) (*Proxy, error) {
-	certConfig, err := NewCertConfig(ca, key)
+func NewProxy(cfg Config) (*Proxy, error) {
+	certConfig, err := NewCertConfig(cfg.CACert, cfg.CAKey)
+	if err != nil {
+		return nil, err
+	}
+
+	certConfig.cache, err = newCertCache(cfg.CertCache)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := validateAuthRules(cfg.AuthRules); err != nil {
+		return nil, err
+	}
+
+	connSeq, err := randomConnSeq()
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = cfg.UpstreamProxy.proxyFunc()
+
+	var roundTripper http.RoundTripper = transport
+	if len(cfg.ClientCerts) > 0 {
+		roundTripper = NewClientCertTransport(transport, cfg.ClientCerts)
+	}
+
 	p := &Proxy{
-		certConfig:   certConfig,
-		reqModifiers: make([]RequestModifyMiddleware, 0),
-		resModifiers: make([]ResponseModifyMiddleware, 0),
+		certConfig:       certConfig,
+		transport:        roundTripper,
+		rateLimiter:      newRateLimiter(cfg.RateLimit),
+		limits:           newLimits(cfg.Limits),
+		passThroughHosts: cfg.PassThroughHosts,
+		authRules:        cfg.AuthRules,
+		connSeq:          connSeq,
+		reqModifiers:     make([]RequestModifyMiddleware, 0),
+		resModifiers:     make([]ResponseModifyMiddleware, 0),
 	}
 
 	p.handler = &httputil.ReverseProxy{
 		Director:       p.modifyRequest,
 		ModifyResponse: p.modifyResponse,
 		ErrorHandler:   errorHandler,
+		Transport:      roundTripper,
 	}
 
 	return p, nil
 }
 
 func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodConnect && matchHost(r.Host, p.passThroughHosts) {
+		p.handleTunnel(w, r)
+		return
+	}
+
 	if r.Method == http.MethodConnect {
-		p.handleConnect(w)
+		p.handleConnect(w, r)
+		return
+	}
+
+	// Requests that exceed the rate limit wait their turn. If the client goes
+	// away in the meantime, the request is dropped.
+	if err := p.rateLimiter.wait(r.Context(), r.Host); err != nil {
+		writeError(w, http.StatusServiceUnavailable)
 		return
 	}
 
+	if timeout := p.limits.forHost(r.Host).Timeout; timeout > 0 {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		r = r.WithContext(ctx)
+	}
+
 	p.handler.ServeHTTP(w, r)
 }
 
+// Transport returns the transport that the proxy sends requests with. It uses
+// the upstream proxy and client certificates of the proxy config, so requests
+// sent with it outside of the proxy (e.g. resent requests) are sent alike.
+func (p *Proxy) Transport() http.RoundTripper {
+	return p.transport
+}
+
 func (p *Proxy) UseRequestModifier(fn ...RequestModifyMiddleware) {
 	p.reqModifiers = append(p.reqModifiers, fn...)
 }
@@ -78,6 +185,14 @@ func (p *Proxy) modifyRequest(r *http.Request) {
 	// set this header.
 	r.Header["X-Forwarded-For"] = nil
 
+	*r = *TraceRequest(r)
+
+	if matchHost(r.URL.Host, p.passThroughHosts) {
+		return
+	}
+
+	injectAuth(r, p.authRules)
+
 	fn := nopReqModifier
 
 	for i := len(p.reqModifiers) - 1; i >= 0; i-- {
@@ -88,6 +203,14 @@ func (p *Proxy) modifyRequest(r *http.Request) {
 }
 
 func (p *Proxy) modifyResponse(res *http.Response) error {
+	if max := p.limits.forHost(res.Request.URL.Host).MaxResponseSize; max > 0 {
+		res.Body = &cappedBody{ReadCloser: res.Body, remaining: max}
+	}
+
+	if matchHost(res.Request.URL.Host, p.passThroughHosts) {
+		return nil
+	}
+
 	fn := nopResModifier
 
 	for i := len(p.resModifiers) - 1; i >= 0; i-- {
@@ -100,7 +223,7 @@ func (p *Proxy) modifyResponse(res *http.Response) error {
 // handleConnect hijacks the incoming HTTP request and sets up an HTTP tunnel.
 // During the TLS handshake with the client, we use the proxy's CA config to
 // create a certificate on-the-fly.
-func (p *Proxy) handleConnect(w http.ResponseWriter) {
+func (p *Proxy) handleConnect(w http.ResponseWriter, r *http.Request) {
 	hj, ok := w.(http.Hijacker)
 	if !ok {
 		log.Printf("[ERROR] handleConnect: ResponseWriter is not a http.Hijacker (type: %T)", w)
@@ -130,7 +253,24 @@ func (p *Proxy) handleConnect(w http.ResponseWriter) {
 	clientConnNotify := ConnNotify{clientConn, make(chan struct{})}
 	l := &OnceAcceptListener{clientConnNotify.Conn}
 
-	err = http.Serve(l, p)
+	// Requests in the tunnel are received on the same client connection as
+	// the CONNECT request, so they share its ID. If it doesn't have one (i.e.
+	// the server doesn't use ConnContext), the tunnel gets a new ID.
+	connID := ConnID(r.Context())
+	if connID == 0 {
+		connID = atomic.AddInt64(&p.connSeq, 1)
+	}
+
+	listener := Listener(r.Context())
+
+	srv := &http.Server{
+		Handler: p,
+		ConnContext: func(ctx context.Context, _ net.Conn) context.Context {
+			return withConnID(withListener(ctx, listener), connID)
+		},
+	}
+
+	err = srv.Serve(l)
 	if err != nil && !errors.Is(err, ErrAlreadyAccepted) {
 		log.Printf("[ERROR] Serving HTTP request failed: %v", err)
 	}
@@ -138,6 +278,56 @@ func (p *Proxy) handleConnect(w http.ResponseWriter) {
 	<-clientConnNotify.closed
 }
 
+// tunnelDialTimeout is the maximum duration for connecting to a pass-through
+// host.
+const tunnelDialTimeout = 30 * time.Second
+
+// handleTunnel hijacks the incoming CONNECT request, and relays data between
+// the client and the requested host as is, without intercepting TLS.
+func (p *Proxy) handleTunnel(w http.ResponseWriter, r *http.Request) {
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		log.Printf("[ERROR] handleTunnel: ResponseWriter is not a http.Hijacker (type: %T)", w)
+		writeError(w, http.StatusServiceUnavailable)
+
+		return
+	}
+
+	dialer := &net.Dialer{Timeout: tunnelDialTimeout}
+
+	targetConn, err := dialer.DialContext(r.Context(), "tcp", r.Host)
+	if err != nil {
+		log.Printf("[ERROR] Dialing pass-through host failed: %v", err)
+		writeError(w, http.StatusBadGateway)
+
+		return
+	}
+	defer targetConn.Close()
+
+	w.WriteHeader(http.StatusOK)
+
+	clientConn, _, err := hj.Hijack()
+	if err != nil {
+		log.Printf("[ERROR] Hijacking client connection failed: %v", err)
+		return
+	}
+	defer clientConn.Close()
+
+	done := make(chan struct{}, 2)
+	relay := func(dst, src net.Conn) {
+		// Errors are expected when either side closes the connection.
+		_, _ = io.Copy(dst, src)
+		done <- struct{}{}
+	}
+
+	go relay(targetConn, clientConn)
+	go relay(clientConn, targetConn)
+
+	// Closing both connections (deferred) when either direction is done makes
+	// the other one return, too.
+	<-done
+}
+
 func (p *Proxy) clientTLSConn(conn net.Conn) (*tls.Conn, error) {
 	tlsConfig := p.certConfig.TLSConfig()
 
@@ -155,6 +345,13 @@ func errorHandler(w http.ResponseWriter, r *http.Request, err error) {
 		return
 	}
 
+	if errors.Is(err, context.DeadlineExceeded) {
+		log.Printf("[ERROR]: Proxy request to %v timed out", r.Host)
+		w.WriteHeader(http.StatusGatewayTimeout)
+
+		return
+	}
+
 	log.Printf("[ERROR]: Proxy error: %v", err)
 
 	w.WriteHeader(http.StatusBadGateway)
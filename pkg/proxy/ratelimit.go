@@ -0,0 +1,79 @@
+package proxy
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimitConfig limits the rate of outbound requests. Requests that exceed
+// a limit wait until they are allowed, or until their context is done.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the limit for all requests combined. Zero means
+	// no global limit.
+	RequestsPerSecond float64
+	// PerHost sets limits (in requests per second) for specific hostnames,
+	// without port. These apply in addition to the global limit.
+	PerHost map[string]float64
+	// Burst is the number of requests that may exceed a limit at once.
+	// Defaults to 1.
+	Burst int
+}
+
+type rateLimiter struct {
+	global  *rate.Limiter
+	perHost map[string]*rate.Limiter
+}
+
+func newRateLimiter(cfg RateLimitConfig) *rateLimiter {
+	if cfg.RequestsPerSecond == 0 && len(cfg.PerHost) == 0 {
+		return nil
+	}
+
+	burst := cfg.Burst
+	if burst <= 0 {
+		burst = 1
+	}
+
+	rl := &rateLimiter{
+		perHost: make(map[string]*rate.Limiter, len(cfg.PerHost)),
+	}
+
+	if cfg.RequestsPerSecond > 0 {
+		rl.global = rate.NewLimiter(rate.Limit(cfg.RequestsPerSecond), burst)
+	}
+
+	for host, rps := range cfg.PerHost {
+		rl.perHost[strings.ToLower(host)] = rate.NewLimiter(rate.Limit(rps), burst)
+	}
+
+	return rl
+}
+
+// wait blocks until a request to host is allowed by both the global and the
+// host limit, or until ctx is done.
+func (rl *rateLimiter) wait(ctx context.Context, host string) error {
+	if rl == nil {
+		return nil
+	}
+
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	if limiter, ok := rl.perHost[strings.ToLower(host)]; ok {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	if rl.global != nil {
+		if err := rl.global.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
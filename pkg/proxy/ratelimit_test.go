@@ -0,0 +1,101 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(target.Close)
+
+	t.Run("delays requests that exceed the limit", func(t *testing.T) {
+		t.Parallel()
+
+		p := newTestProxy(t, Config{
+			RateLimit: RateLimitConfig{RequestsPerSecond: 20},
+		})
+
+		start := time.Now()
+
+		for i := 0; i < 5; i++ {
+			rec := httptest.NewRecorder()
+			p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target.URL, nil))
+
+			if rec.Code != http.StatusNoContent {
+				t.Fatalf("expected status %v, got: %v", http.StatusNoContent, rec.Code)
+			}
+		}
+
+		// The first request is allowed by the burst, the next four are spaced
+		// 50ms apart.
+		if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+			t.Errorf("expected requests to be delayed, took: %v", elapsed)
+		}
+	})
+
+	t.Run("applies per host limits", func(t *testing.T) {
+		t.Parallel()
+
+		rl := newRateLimiter(RateLimitConfig{PerHost: map[string]float64{"slow.example.com": 0.1}})
+		ctx := context.Background()
+
+		for i := 0; i < 5; i++ {
+			if err := rl.wait(ctx, "fast.example.com:443"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		if err := rl.wait(ctx, "slow.example.com:443"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		if err := rl.wait(ctx, "slow.example.com"); err == nil {
+			t.Error("expected second request to slow host to be limited")
+		}
+	})
+
+	t.Run("context cancellation unblocks waiting requests", func(t *testing.T) {
+		t.Parallel()
+
+		rl := newRateLimiter(RateLimitConfig{RequestsPerSecond: 0.01})
+
+		if err := rl.wait(context.Background(), "example.com"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errc := make(chan error, 1)
+
+		go func() {
+			// Use a deadline far in the future, so that the limiter waits
+			// instead of failing fast.
+			ctx, cancelTimeout := context.WithTimeout(ctx, time.Hour)
+			defer cancelTimeout()
+			errc <- rl.wait(ctx, "example.com")
+		}()
+
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+
+		select {
+		case err := <-errc:
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("expected context.Canceled error, got: %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("expected cancellation to unblock waiting request")
+		}
+	})
+}
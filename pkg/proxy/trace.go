@@ -0,0 +1,147 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+const connTraceKey contextKey = 1
+
+// Timings is the duration of the phases of an outbound request. Phases that
+// didn't happen are nil, e.g. DNS, Connect and TLS when a connection was
+// reused, or DNS when the host is an IP address.
+type Timings struct {
+	DNS     *time.Duration
+	Connect *time.Duration
+	TLS     *time.Duration
+	// TTFB (time to first byte) is the time from the connection being ready
+	// until the first byte of the response was received.
+	TTFB *time.Duration
+}
+
+// IsZero reports whether none of the phases were recorded.
+func (t Timings) IsZero() bool {
+	return t.DNS == nil && t.Connect == nil && t.TLS == nil && t.TTFB == nil
+}
+
+// connTrace holds the remote address of the connection an outbound request was
+// sent on, and the timestamps of its phases. It's written by the transport, via
+// an `httptrace.ClientTrace`.
+type connTrace struct {
+	mu         sync.Mutex
+	addr       string
+	clientCert bool
+
+	dnsStart, dnsDone         time.Time
+	connectStart, connectDone time.Time
+	tlsStart, tlsDone         time.Time
+	gotConn, gotFirstByte     time.Time
+}
+
+// TraceRequest returns a copy of req that records the remote address of the
+// connection it's sent on, its timings, and whether a client certificate was
+// presented on that connection, for retrieval via ServerAddr, RequestTimings and
+// ClientCertUsed. Requests forwarded by the proxy are traced already.
+func TraceRequest(req *http.Request) *http.Request {
+	ct := &connTrace{}
+	record := func(fn func()) {
+		ct.mu.Lock()
+		defer ct.mu.Unlock()
+
+		fn()
+	}
+
+	trace := &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) { record(func() { ct.dnsStart = time.Now() }) },
+		DNSDone:  func(httptrace.DNSDoneInfo) { record(func() { ct.dnsDone = time.Now() }) },
+		// With multiple addresses, connections may be attempted in parallel.
+		// The first attempt and the last completion are recorded.
+		ConnectStart: func(_, _ string) {
+			record(func() {
+				if ct.connectStart.IsZero() {
+					ct.connectStart = time.Now()
+				}
+			})
+		},
+		ConnectDone:       func(_, _ string, _ error) { record(func() { ct.connectDone = time.Now() }) },
+		TLSHandshakeStart: func() { record(func() { ct.tlsStart = time.Now() }) },
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			record(func() { ct.tlsDone = time.Now() })
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			record(func() {
+				ct.addr = info.Conn.RemoteAddr().String()
+				ct.gotConn = time.Now()
+			})
+		},
+		GotFirstResponseByte: func() { record(func() { ct.gotFirstByte = time.Now() }) },
+	}
+
+	ctx := context.WithValue(req.Context(), connTraceKey, ct)
+
+	return req.WithContext(httptrace.WithClientTrace(ctx, trace))
+}
+
+// ServerAddr returns the remote address (e.g. "93.184.216.34:443") of the
+// connection that the outbound request of ctx was sent on. When an upstream
+// proxy is used, this is the address of the upstream proxy. It returns an empty
+// string if the request wasn't sent (yet).
+func ServerAddr(ctx context.Context) string {
+	ct, ok := ctx.Value(connTraceKey).(*connTrace)
+	if !ok {
+		return ""
+	}
+
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	return ct.addr
+}
+
+// ClientCertUsed reports whether the outbound request of ctx was sent on a
+// connection that a client certificate of NewClientCertTransport was presented
+// on.
+func ClientCertUsed(ctx context.Context) bool {
+	ct, ok := ctx.Value(connTraceKey).(*connTrace)
+	if !ok {
+		return false
+	}
+
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	return ct.clientCert
+}
+
+// RequestTimings returns the timings of the outbound request of ctx. Phases
+// that didn't happen (yet) are nil.
+func RequestTimings(ctx context.Context) Timings {
+	ct, ok := ctx.Value(connTraceKey).(*connTrace)
+	if !ok {
+		return Timings{}
+	}
+
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	return Timings{
+		DNS:     between(ct.dnsStart, ct.dnsDone),
+		Connect: between(ct.connectStart, ct.connectDone),
+		TLS:     between(ct.tlsStart, ct.tlsDone),
+		TTFB:    between(ct.gotConn, ct.gotFirstByte),
+	}
+}
+
+func between(start, end time.Time) *time.Duration {
+	if start.IsZero() || end.IsZero() {
+		return nil
+	}
+
+	d := end.Sub(start)
+
+	return &d
+}
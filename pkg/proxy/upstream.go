@@ -0,0 +1,100 @@
+package proxy
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// UpstreamProxyConfig defines an upstream (parent) proxy that outbound
+// requests are chained through. Supported URL schemes are `http`, `https`
+// and `socks5`.
+type UpstreamProxyConfig struct {
+	URL *url.URL
+	// Credentials, when set, take precedence over userinfo in URL. This allows
+	// credentials to be supplied separately from the (logged) proxy address.
+	Credentials *url.Userinfo
+	// NoProxy is a list of hosts that are dialed directly. An entry matches
+	// the request host exactly, or as a domain suffix when prefixed with a dot
+	// (e.g. ".example.com"). A single "*" bypasses the upstream proxy for all
+	// hosts.
+	NoProxy []string
+}
+
+// ParseUpstreamProxyURL parses and validates an upstream proxy URL.
+func ParseUpstreamProxyURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: could not parse upstream proxy URL: %w", err)
+	}
+
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("proxy: unsupported upstream proxy scheme (%v)", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return nil, fmt.Errorf("proxy: upstream proxy URL is missing host")
+	}
+
+	return u, nil
+}
+
+// proxyFunc returns a function that can be used for `http.Transport.Proxy`.
+func (cfg *UpstreamProxyConfig) proxyFunc() func(*http.Request) (*url.URL, error) {
+	if cfg == nil || cfg.URL == nil {
+		return http.ProxyFromEnvironment
+	}
+
+	proxyURL := *cfg.URL
+	if cfg.Credentials != nil {
+		proxyURL.User = cfg.Credentials
+	}
+
+	return func(req *http.Request) (*url.URL, error) {
+		if cfg.bypass(req.URL.Host) {
+			return nil, nil
+		}
+
+		return &proxyURL, nil
+	}
+}
+
+func (cfg *UpstreamProxyConfig) bypass(hostport string) bool {
+	return matchHost(hostport, cfg.NoProxy)
+}
+
+// matchHost reports whether the host of hostport matches any of the patterns.
+// A pattern matches the host exactly, or as a domain suffix when prefixed with
+// a dot (e.g. ".example.com"). A single "*" matches all hosts. Matching is
+// case-insensitive.
+func matchHost(hostport string, patterns []string) bool {
+	host, _, err := net.SplitHostPort(hostport)
+	if err != nil {
+		host = hostport
+	}
+
+	host = strings.ToLower(host)
+
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+
+		switch {
+		case pattern == "":
+			continue
+		case pattern == "*":
+			return true
+		case strings.HasPrefix(pattern, "."):
+			if strings.HasSuffix(host, pattern) || host == pattern[1:] {
+				return true
+			}
+		case host == pattern:
+			return true
+		}
+	}
+
+	return false
+}
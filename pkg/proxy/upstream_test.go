@@ -0,0 +1,149 @@
+package proxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func newTestProxy(t *testing.T, cfg Config) *Proxy {
+	t.Helper()
+
+	caCert, caKey, err := NewCA("Hetty", "Hetty CA", time.Hour)
+	if err != nil {
+		t.Fatalf("could not create CA: %v", err)
+	}
+
+	cfg.CACert = caCert
+	cfg.CAKey = caKey
+
+	p, err := NewProxy(cfg)
+	if err != nil {
+		t.Fatalf("could not create proxy: %v", err)
+	}
+
+	return p
+}
+
+func TestUpstreamProxy(t *testing.T) {
+	t.Parallel()
+
+	upstreamHits := make(chan string, 1)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstreamHits <- r.URL.String() + " " + r.Header.Get("Proxy-Authorization")
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	defer upstream.Close()
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer target.Close()
+
+	upstreamURL, err := ParseUpstreamProxyURL(upstream.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Run("routes through upstream when configured", func(t *testing.T) {
+		p := newTestProxy(t, Config{
+			UpstreamProxy: &UpstreamProxyConfig{
+				URL:         upstreamURL,
+				Credentials: url.UserPassword("foo", "bar"),
+			},
+		})
+
+		rec := httptest.NewRecorder()
+		p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "http://example.test/foo", nil))
+
+		if rec.Code != http.StatusTeapot {
+			t.Fatalf("expected status %v, got: %v", http.StatusTeapot, rec.Code)
+		}
+
+		exp := "http://example.test/foo Basic Zm9vOmJhcg=="
+		if got := <-upstreamHits; got != exp {
+			t.Errorf("expected upstream to receive %q, got: %q", exp, got)
+		}
+	})
+
+	t.Run("routes requests sent with the transport through upstream", func(t *testing.T) {
+		p := newTestProxy(t, Config{
+			UpstreamProxy: &UpstreamProxyConfig{URL: upstreamURL},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "http://example.test/bar", nil)
+		req.RequestURI = ""
+
+		res, err := p.Transport().RoundTrip(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		res.Body.Close()
+
+		if res.StatusCode != http.StatusTeapot {
+			t.Fatalf("expected status %v, got: %v", http.StatusTeapot, res.StatusCode)
+		}
+
+		exp := "http://example.test/bar "
+		if got := <-upstreamHits; got != exp {
+			t.Errorf("expected upstream to receive %q, got: %q", exp, got)
+		}
+	})
+
+	t.Run("dials directly for no-proxy hosts", func(t *testing.T) {
+		p := newTestProxy(t, Config{
+			UpstreamProxy: &UpstreamProxyConfig{
+				URL:     upstreamURL,
+				NoProxy: []string{"127.0.0.1"},
+			},
+		})
+
+		rec := httptest.NewRecorder()
+		p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target.URL, nil))
+
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("expected status %v, got: %v", http.StatusNoContent, rec.Code)
+		}
+	})
+
+	t.Run("dials directly when not configured", func(t *testing.T) {
+		p := newTestProxy(t, Config{})
+
+		rec := httptest.NewRecorder()
+		p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target.URL, nil))
+
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("expected status %v, got: %v", http.StatusNoContent, rec.Code)
+		}
+	})
+}
+
+func TestUpstreamProxyBypass(t *testing.T) {
+	t.Parallel()
+
+	cfg := &UpstreamProxyConfig{NoProxy: []string{"localhost", ".example.com"}}
+
+	tests := []struct {
+		host string
+		exp  bool
+	}{
+		{host: "localhost:8080", exp: true},
+		{host: "example.com", exp: true},
+		{host: "api.example.com:443", exp: true},
+		{host: "notexample.com", exp: false},
+		{host: "example.org", exp: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.host, func(t *testing.T) {
+			t.Parallel()
+
+			if got := cfg.bypass(tt.host); got != tt.exp {
+				t.Errorf("expected bypass to be %v, got: %v", tt.exp, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package reqlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+const (
+	defaultAsyncQueueSize = 1000
+	asyncBatchSize        = 100
+	asyncFlushInterval    = 100 * time.Millisecond
+)
+
+// asyncLog is a request log or a response log, queued to be stored by the
+// async writer. Request logs are queued before the request is sent, and
+// response logs once the response is received, so that requests without a
+// response are stored, too.
+type asyncLog struct {
+	reqLog Request
+	// parent is the queued request log of a response log, or nil for a
+	// request log. Because the queue is written in order, the parent is always
+	// written first.
+	parent *asyncLog
+	// id is the ID of a stored request log. It's only accessed by the
+	// writer.
+	id int64
+}
+
+// asyncWriter stores queued logs in batches, using a single transaction per
+// batch. A batch is written when it's full, or periodically.
+type asyncWriter struct {
+	repo  Repository
+	queue chan *asyncLog
+	flush chan chan struct{}
+	done  chan struct{}
+}
+
+func newAsyncWriter(repo Repository, queueSize int) *asyncWriter {
+	if queueSize <= 0 {
+		queueSize = defaultAsyncQueueSize
+	}
+
+	w := &asyncWriter{
+		repo:  repo,
+		queue: make(chan *asyncLog, queueSize),
+		flush: make(chan chan struct{}),
+		done:  make(chan struct{}),
+	}
+
+	go w.run()
+
+	return w
+}
+
+// run writes queued logs until the queue is closed and drained.
+func (w *asyncWriter) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(asyncFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*asyncLog, 0, asyncBatchSize)
+
+	for {
+		select {
+		case l, ok := <-w.queue:
+			if !ok {
+				w.write(batch)
+				return
+			}
+
+			batch = append(batch, l)
+			if len(batch) < asyncBatchSize {
+				continue
+			}
+		case <-ticker.C:
+		case flushed := <-w.flush:
+			var closed bool
+
+			batch, closed = w.drain(batch)
+			w.write(batch)
+			close(flushed)
+
+			if closed {
+				return
+			}
+
+			batch = batch[:0]
+
+			continue
+		}
+
+		w.write(batch)
+		batch = batch[:0]
+	}
+}
+
+// drain appends all logs that are currently queued to batch. It reports
+// whether the queue was closed.
+func (w *asyncWriter) drain(batch []*asyncLog) ([]*asyncLog, bool) {
+	for {
+		select {
+		case l, ok := <-w.queue:
+			if !ok {
+				return batch, true
+			}
+
+			batch = append(batch, l)
+		default:
+			return batch, false
+		}
+	}
+}
+
+func (w *asyncWriter) write(batch []*asyncLog) {
+	if len(batch) == 0 {
+		return
+	}
+
+	ctx := context.Background()
+
+	err := w.repo.WithTx(ctx, func(tx Repository) error {
+		for _, l := range batch {
+			// Each log is written in a nested transaction, so that a log that
+			// can't be stored doesn't roll back the rest of the batch.
+			if err := tx.WithTx(ctx, func(tx Repository) error {
+				return writeAsyncLog(ctx, tx, l)
+			}); err != nil {
+				log.Printf("[ERROR] Could not store request log: %v", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Printf("[ERROR] Could not store batch of %v request logs: %v", len(batch), err)
+	}
+}
+
+func writeAsyncLog(ctx context.Context, repo Repository, l *asyncLog) error {
+	if l.parent == nil {
+		reqLog, err := repo.AddRequestLog(ctx, l.reqLog)
+		if err != nil {
+			return err
+		}
+
+		l.id = reqLog.ID
+
+		return nil
+	}
+
+	if l.parent.id == 0 {
+		return errors.New("reqlog: request log of response log wasn't stored")
+	}
+
+	resLog := l.reqLog
+	resLog.ID = l.parent.id
+
+	_, err := addResponse(ctx, repo, resLog)
+
+	return err
+}
+
+// enqueue queues l to be stored by the async writer. If the queue is full, it
+// blocks until there's room, or ctx is done.
+func (svc *Service) enqueue(ctx context.Context, l *asyncLog) error {
+	svc.mu.Lock()
+
+	if svc.shutdown {
+		svc.mu.Unlock()
+		return ErrShutdown
+	}
+
+	svc.writes.Add(1)
+	svc.mu.Unlock()
+
+	defer svc.writes.Done()
+
+	select {
+	case svc.async.queue <- l:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("reqlog: could not queue request log: %w", ctx.Err())
+	}
+}
+
+// Flush waits until all logs that are queued by the async writer are stored.
+// It's a no-op if async writes are disabled.
+func (svc *Service) Flush(ctx context.Context) error {
+	if svc.async == nil {
+		return nil
+	}
+
+	flushed := make(chan struct{})
+
+	select {
+	case svc.async.flush <- flushed:
+	case <-svc.async.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("reqlog: could not flush request logs: %w", ctx.Err())
+	}
+
+	select {
+	case <-flushed:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("reqlog: could not flush request logs: %w", ctx.Err())
+	}
+}
+
+// AsyncWrites reports whether request logs are stored by the async writer.
+func (svc *Service) AsyncWrites() bool {
+	return svc.async != nil
+}
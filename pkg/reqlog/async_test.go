@@ -0,0 +1,119 @@
+package reqlog_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestAsyncWrites(t *testing.T) {
+	t.Parallel()
+
+	// A small queue makes concurrent responses block on a full queue.
+	svc, db := newTestServiceWithConfig(t, reqlog.Config{AsyncWrites: true, AsyncQueueSize: 4})
+	ctx := context.Background()
+
+	if !svc.AsyncWrites() {
+		t.Fatal("expected async writes to be enabled")
+	}
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("foobar"))
+	}))
+	defer target.Close()
+
+	p := newTestProxy(t, svc)
+
+	const n = 250
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			rec := httptest.NewRecorder()
+			p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target.URL, nil))
+
+			if rec.Code != http.StatusOK {
+				t.Errorf("expected status %v, got: %v", http.StatusOK, rec.Code)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if err := svc.Flush(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	reqLogs, err := db.FindRequestLogs(reqlog.WithAllFields(ctx), reqlog.FindRequestsFilter{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reqLogs) != n {
+		t.Fatalf("expected %v request logs, got: %v", n, len(reqLogs))
+	}
+
+	for _, reqLog := range reqLogs {
+		if reqLog.Response == nil || string(reqLog.Response.Body) != "foobar" {
+			t.Fatalf("expected response log for request log %v to be stored", reqLog.ID)
+		}
+
+		if reqLog.ServerAddr != target.Listener.Addr().String() {
+			t.Errorf("expected server address %q, got: %q", target.Listener.Addr(), reqLog.ServerAddr)
+		}
+	}
+
+	if err := svc.Shutdown(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestAsyncWritesWithoutResponse(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestServiceWithConfig(t, reqlog.Config{AsyncWrites: true})
+	ctx := context.Background()
+
+	// The target is closed, so the request fails upstream.
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	target.Close()
+
+	p := newTestProxy(t, svc)
+
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target.URL, nil))
+
+	if rec.Code != http.StatusBadGateway {
+		t.Fatalf("expected status %v, got: %v", http.StatusBadGateway, rec.Code)
+	}
+
+	if err := svc.Flush(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	reqLogs, err := db.FindRequestLogs(reqlog.WithAllFields(ctx), reqlog.FindRequestsFilter{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reqLogs) != 1 {
+		t.Fatalf("expected 1 request log, got: %v", len(reqLogs))
+	}
+
+	if reqLogs[0].Response != nil {
+		t.Errorf("expected request log without response log, got: %+v", reqLogs[0].Response)
+	}
+
+	if err := svc.Shutdown(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,232 @@
+package reqlog
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// maxDiffLines is the maximum number of lines of each body that are diffed.
+// Because the diff takes quadratic time and memory, lines beyond are ignored.
+const maxDiffLines = 1000
+
+var (
+	ErrNotBaseline = errors.New("reqlog: request log is not a baseline")
+	ErrNoResponse  = errors.New("reqlog: request log has no response")
+)
+
+// BaselineComparison describes how the response of a request log differs from
+// the response of a baseline request log.
+type BaselineComparison struct {
+	StatusCode         int
+	BaselineStatusCode int
+	// BodySize and BaselineBodySize are the sizes (in bytes) of the stored
+	// response bodies.
+	BodySize         int
+	BaselineBodySize int
+	BodyChanged      bool
+	// Headers are the response headers that differ, sorted by key. Headers
+	// that are missing on either side have no values on that side.
+	Headers []HeaderDiff
+	// BodyDiff are the lines of the response bodies that were deleted from
+	// the baseline, or inserted in the compared body. BodyDiffTruncated is
+	// true if either body had more than the maximum number of diffed lines.
+	BodyDiff          []LineDiff
+	BodyDiffTruncated bool
+}
+
+// StatusChanged reports whether the status code differs from the baseline.
+func (c BaselineComparison) StatusChanged() bool {
+	return c.StatusCode != c.BaselineStatusCode
+}
+
+// BodySizeDelta is the difference in body size (in bytes) compared to the
+// baseline. It's negative if the body got smaller.
+func (c BaselineComparison) BodySizeDelta() int {
+	return c.BodySize - c.BaselineBodySize
+}
+
+type HeaderDiff struct {
+	Key            string
+	Values         []string
+	BaselineValues []string
+}
+
+type DiffOp int
+
+const (
+	DiffInsert DiffOp = iota
+	DiffDelete
+)
+
+// LineDiff is a line that was inserted or deleted. Line is the 1-based line
+// number in the compared body for insertions, and in the baseline body for
+// deletions.
+type LineDiff struct {
+	Op   DiffOp
+	Line int
+	Text string
+}
+
+// SetBaseline marks (or unmarks) a request log as a baseline.
+func (svc *Service) SetBaseline(ctx context.Context, id int64, baseline bool) error {
+	return svc.repo.SetRequestLogBaseline(ctx, id, baseline)
+}
+
+// CompareToBaseline compares the response of a request log to the response of
+// a request log that's marked as a baseline.
+func (svc *Service) CompareToBaseline(ctx context.Context, id, baselineID int64) (BaselineComparison, error) {
+	ctx = WithAllFields(ctx)
+
+	baseline, err := svc.repo.FindRequestLogByID(ctx, baselineID)
+	if err != nil {
+		return BaselineComparison{}, fmt.Errorf("reqlog: could not find baseline request log: %w", err)
+	}
+
+	if !baseline.IsBaseline {
+		return BaselineComparison{}, ErrNotBaseline
+	}
+
+	reqLog, err := svc.repo.FindRequestLogByID(ctx, id)
+	if err != nil {
+		return BaselineComparison{}, fmt.Errorf("reqlog: could not find request log: %w", err)
+	}
+
+	if reqLog.Response == nil || baseline.Response == nil {
+		return BaselineComparison{}, ErrNoResponse
+	}
+
+	res, baseRes := reqLog.Response, baseline.Response
+
+	bodyDiff, truncated := diffLines(baseRes.Body, res.Body)
+
+	return BaselineComparison{
+		StatusCode:         res.Response.StatusCode,
+		BaselineStatusCode: baseRes.Response.StatusCode,
+		BodySize:           len(res.Body),
+		BaselineBodySize:   len(baseRes.Body),
+		BodyChanged:        !bytes.Equal(res.Body, baseRes.Body),
+		Headers:            diffHeaders(baseRes.Response.Header, res.Response.Header),
+		BodyDiff:           bodyDiff,
+		BodyDiffTruncated:  truncated,
+	}, nil
+}
+
+func diffHeaders(baseline, header http.Header) []HeaderDiff {
+	keys := make(map[string]struct{}, len(header))
+
+	for key := range baseline {
+		keys[http.CanonicalHeaderKey(key)] = struct{}{}
+	}
+
+	for key := range header {
+		keys[http.CanonicalHeaderKey(key)] = struct{}{}
+	}
+
+	var diffs []HeaderDiff
+
+	for key := range keys {
+		values, baselineValues := header.Values(key), baseline.Values(key)
+		if equalStrings(values, baselineValues) {
+			continue
+		}
+
+		diffs = append(diffs, HeaderDiff{
+			Key:            key,
+			Values:         values,
+			BaselineValues: baselineValues,
+		})
+	}
+
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Key < diffs[j].Key })
+
+	return diffs
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// diffLines returns the lines that were deleted from a and inserted in b, based
+// on their longest common subsequence of lines. It reports whether either body
+// was cut off at maxDiffLines.
+func diffLines(a, b []byte) ([]LineDiff, bool) {
+	if bytes.Equal(a, b) {
+		return nil, false
+	}
+
+	linesA, truncA := splitLines(a)
+	linesB, truncB := splitLines(b)
+
+	// lcs[i][j] is the length of the longest common subsequence of
+	// linesA[i:] and linesB[j:].
+	lcs := make([][]int, len(linesA)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(linesB)+1)
+	}
+
+	for i := len(linesA) - 1; i >= 0; i-- {
+		for j := len(linesB) - 1; j >= 0; j-- {
+			switch {
+			case linesA[i] == linesB[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var diffs []LineDiff
+
+	i, j := 0, 0
+	for i < len(linesA) || j < len(linesB) {
+		switch {
+		case i < len(linesA) && j < len(linesB) && linesA[i] == linesB[j]:
+			i++
+			j++
+		case j < len(linesB) && (i == len(linesA) || lcs[i][j+1] > lcs[i+1][j]):
+			diffs = append(diffs, LineDiff{Op: DiffInsert, Line: j + 1, Text: linesB[j]})
+			j++
+		default:
+			diffs = append(diffs, LineDiff{Op: DiffDelete, Line: i + 1, Text: linesA[i]})
+			i++
+		}
+	}
+
+	return diffs, truncA || truncB
+}
+
+func splitLines(b []byte) ([]string, bool) {
+	if len(b) == 0 {
+		return nil, false
+	}
+
+	parts := bytes.SplitN(b, []byte("\n"), maxDiffLines+1)
+	truncated := len(parts) > maxDiffLines
+
+	if truncated {
+		parts = parts[:maxDiffLines]
+	}
+
+	lines := make([]string, len(parts))
+	for i, part := range parts {
+		lines[i] = string(part)
+	}
+
+	return lines, truncated
+}
@@ -0,0 +1,94 @@
+package reqlog_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestCompareToBaseline(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	addLog := func(status string, statusCode int, header http.Header, body string) int64 {
+		t.Helper()
+
+		res := http.Response{Status: status, StatusCode: statusCode, Proto: "HTTP/1.1", Header: header}
+
+		return addTestRequestLog(t, db, reqlog.Request{
+			Request:  *httptest.NewRequest(http.MethodGet, "https://example.com/", nil),
+			Response: &reqlog.Response{Response: res, Body: []byte(body)},
+		}).ID
+	}
+
+	baselineID := addLog("200 OK", http.StatusOK,
+		http.Header{"Content-Type": []string{"text/plain"}, "X-Foo": []string{"bar"}},
+		"foo\nbar\nbaz",
+	)
+	changedID := addLog("500 Internal Server Error", http.StatusInternalServerError,
+		http.Header{"Content-Type": []string{"text/plain"}, "X-Error": []string{"yes"}},
+		"foo\nerror\nbaz\nqux",
+	)
+
+	if _, err := svc.CompareToBaseline(ctx, changedID, baselineID); !errors.Is(err, reqlog.ErrNotBaseline) {
+		t.Fatalf("expected error %v, got: %v", reqlog.ErrNotBaseline, err)
+	}
+
+	if err := svc.SetBaseline(ctx, baselineID, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := svc.CompareToBaseline(ctx, changedID, baselineID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !got.StatusChanged() {
+		t.Error("expected status to be changed")
+	}
+
+	if got.StatusCode != http.StatusInternalServerError || got.BaselineStatusCode != http.StatusOK {
+		t.Errorf("expected status codes 500 and 200 (baseline), got: %v and %v", got.StatusCode, got.BaselineStatusCode)
+	}
+
+	if exp := 6; got.BodySizeDelta() != exp {
+		t.Errorf("expected body size delta %v, got: %v", exp, got.BodySizeDelta())
+	}
+
+	if !got.BodyChanged {
+		t.Error("expected body to be changed")
+	}
+
+	expHeaders := []reqlog.HeaderDiff{
+		{Key: "X-Error", Values: []string{"yes"}},
+		{Key: "X-Foo", BaselineValues: []string{"bar"}},
+	}
+	if !reflect.DeepEqual(got.Headers, expHeaders) {
+		t.Errorf("expected header diffs %+v, got: %+v", expHeaders, got.Headers)
+	}
+
+	expBodyDiff := []reqlog.LineDiff{
+		{Op: reqlog.DiffDelete, Line: 2, Text: "bar"},
+		{Op: reqlog.DiffInsert, Line: 2, Text: "error"},
+		{Op: reqlog.DiffInsert, Line: 4, Text: "qux"},
+	}
+	if !reflect.DeepEqual(got.BodyDiff, expBodyDiff) {
+		t.Errorf("expected body diff %+v, got: %+v", expBodyDiff, got.BodyDiff)
+	}
+
+	same, err := svc.CompareToBaseline(ctx, baselineID, baselineID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if same.StatusChanged() || same.BodyChanged || len(same.Headers) != 0 || len(same.BodyDiff) != 0 {
+		t.Errorf("expected no differences compared to itself, got: %+v", same)
+	}
+}
@@ -0,0 +1,41 @@
+package reqlog
+
+import (
+	"context"
+	"strings"
+)
+
+const (
+	defaultTopBodyHashesLimit = 10
+	maxTopBodyHashesLimit     = 100
+)
+
+// BodyHashCount is the number of request and response bodies with a hash.
+type BodyHashCount struct {
+	Hash  string
+	Count int64
+	// RequestCount is the number of distinct request logs with a request body
+	// or response body with the hash.
+	RequestCount int64
+}
+
+// FindRequestsByBodyHash returns the request logs with a request body or
+// response body with the (hex encoded SHA-256) hash, e.g. to find a payload
+// that was reused across URLs.
+func (svc *Service) FindRequestsByBodyHash(ctx context.Context, hash string) ([]Request, error) {
+	return svc.repo.FindRequestLogsByBodyHash(ctx, strings.ToLower(hash))
+}
+
+// TopBodyHashes returns the hashes of the most common request and response
+// bodies. Empty bodies aren't hashed. The limit defaults to 10 if it's not
+// positive, and is capped at 100.
+func (svc *Service) TopBodyHashes(ctx context.Context, limit int) ([]BodyHashCount, error) {
+	switch {
+	case limit <= 0:
+		limit = defaultTopBodyHashesLimit
+	case limit > maxTopBodyHashesLimit:
+		limit = maxTopBodyHashesLimit
+	}
+
+	return svc.repo.FindTopBodyHashes(ctx, limit)
+}
@@ -0,0 +1,79 @@
+package reqlog_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestFindRequestsByBodyHash(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	addReqLog := func(url, reqBody, resBody string) *reqlog.Request {
+		res := http.Response{StatusCode: http.StatusOK, Proto: "HTTP/1.1", Header: http.Header{}}
+
+		return addTestRequestLog(t, db, reqlog.Request{
+			Request:  *httptest.NewRequest(http.MethodPost, url, nil),
+			Body:     []byte(reqBody),
+			Response: &reqlog.Response{Response: res, Body: []byte(resBody)},
+		})
+	}
+
+	first := addReqLog("https://example.com/a", "payload", "ok")
+	second := addReqLog("https://example.org/b", "payload", "")
+	third := addReqLog("https://example.net/c", "other", "payload")
+	addReqLog("https://example.com/d", "", "")
+
+	if first.BodyHash != second.BodyHash {
+		t.Errorf("expected identical bodies to share a hash, got: %v and %v", first.BodyHash, second.BodyHash)
+	}
+
+	sum := sha256.Sum256([]byte("payload"))
+	hash := hex.EncodeToString(sum[:])
+
+	if first.BodyHash != hash {
+		t.Errorf("expected body hash %v, got: %v", hash, first.BodyHash)
+	}
+
+	got, err := svc.FindRequestsByBodyHash(ctx, strings.ToUpper(hash))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	gotIDs := make([]int64, len(got))
+	for i, reqLog := range got {
+		gotIDs[i] = reqLog.ID
+	}
+
+	if exp := []int64{third.ID, second.ID, first.ID}; !reflect.DeepEqual(exp, gotIDs) {
+		t.Errorf("expected request logs %v, got: %v", exp, gotIDs)
+	}
+
+	if got[1].BodyHash != hash {
+		t.Errorf("expected stored body hash %v, got: %v", hash, got[1].BodyHash)
+	}
+
+	if got[0].Response == nil || got[0].Response.BodyHash != hash {
+		t.Errorf("expected stored response body hash %v, got: %+v", hash, got[0].Response)
+	}
+
+	topHashes, err := svc.TopBodyHashes(ctx, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	exp := []reqlog.BodyHashCount{{Hash: hash, Count: 3, RequestCount: 3}}
+	if !reflect.DeepEqual(exp, topHashes) {
+		t.Errorf("expected top body hashes %+v, got: %+v", exp, topHashes)
+	}
+}
@@ -0,0 +1,328 @@
+package reqlog
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// burpTimeLayout is the layout of the `time` field of Burp items, which is the
+// default string representation of a Java date, without its time zone.
+const burpTimeLayout = "Mon Jan 02 15:04:05 2006"
+
+// burpTimeZones are the offsets (in seconds east of UTC) of the time zone
+// abbreviations in Burp times. `time.Parse` only knows the abbreviations of the
+// local time zone, and assumes an offset of zero for others. Ambiguous
+// abbreviations, like CST and IST, aren't included.
+var burpTimeZones = map[string]int{
+	"UTC":  0,
+	"GMT":  0,
+	"WET":  0,
+	"WEST": 1 * 3600,
+	"BST":  1 * 3600,
+	"CET":  1 * 3600,
+	"CEST": 2 * 3600,
+	"EET":  2 * 3600,
+	"EEST": 3 * 3600,
+	"MSK":  3 * 3600,
+	"HKT":  8 * 3600,
+	"SGT":  8 * 3600,
+	"AWST": 8 * 3600,
+	"JST":  9 * 3600,
+	"KST":  9 * 3600,
+	"ACST": 9*3600 + 1800,
+	"ACDT": 10*3600 + 1800,
+	"AEST": 10 * 3600,
+	"AEDT": 11 * 3600,
+	"NZST": 12 * 3600,
+	"NZDT": 13 * 3600,
+	"HST":  -10 * 3600,
+	"AKST": -9 * 3600,
+	"AKDT": -8 * 3600,
+	"PST":  -8 * 3600,
+	"PDT":  -7 * 3600,
+	"MST":  -7 * 3600,
+	"MDT":  -6 * 3600,
+	"CDT":  -5 * 3600,
+	"EST":  -5 * 3600,
+	"EDT":  -4 * 3600,
+}
+
+// burpImportBatchSize is the number of items that are stored per transaction.
+const burpImportBatchSize = 500
+
+// BurpImportResult is the result of a Burp import.
+type BurpImportResult struct {
+	// Items is the number of items in the export.
+	Items int
+	// Imported is the number of items that were stored. Items that were
+	// imported before aren't stored again.
+	Imported int
+	// Warnings are the problems with individual items. Items that couldn't be
+	// parsed at all are skipped.
+	Warnings []ImportWarning
+}
+
+// ImportWarning is a problem with an item of an import.
+type ImportWarning struct {
+	// Item is the position of the item in the import, starting at 1.
+	Item    int
+	Message string
+}
+
+func (w ImportWarning) String() string {
+	return fmt.Sprintf("item %v: %v", w.Item, w.Message)
+}
+
+type burpItem struct {
+	Time     string   `xml:"time"`
+	URL      string   `xml:"url"`
+	Request  burpBlob `xml:"request"`
+	Response burpBlob `xml:"response"`
+}
+
+// burpBlob is a raw request or response of a Burp item, which is base64
+// encoded if the export was made with that option.
+type burpBlob struct {
+	Base64 bool   `xml:"base64,attr"`
+	Data   string `xml:",chardata"`
+}
+
+func (b burpBlob) decode() ([]byte, error) {
+	if !b.Base64 {
+		return []byte(b.Data), nil
+	}
+
+	return base64.StdEncoding.DecodeString(strings.TrimSpace(b.Data))
+}
+
+// ImportBurp stores the items of a Burp Suite XML export (e.g. of saved items,
+// or of the proxy history) as request logs. Items that can't be parsed are
+// skipped, with a warning, rather than failing the import. Imports are
+// idempotent: items that were imported before are skipped.
+func (svc *Service) ImportBurp(ctx context.Context, r io.Reader) (BurpImportResult, error) {
+	var result BurpImportResult
+
+	dec := xml.NewDecoder(r)
+	entries := make([]ImportEntry, 0, burpImportBatchSize)
+
+	flush := func() error {
+		if len(entries) == 0 {
+			return nil
+		}
+
+		n, err := svc.repo.BulkImportRequestLogs(ctx, entries)
+		if err != nil {
+			return err
+		}
+
+		result.Imported += n
+		entries = entries[:0]
+
+		return nil
+	}
+
+	for {
+		token, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return result, fmt.Errorf("reqlog: could not parse Burp XML: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "item" {
+			continue
+		}
+
+		var item burpItem
+		if err := dec.DecodeElement(&item, &start); err != nil {
+			return result, fmt.Errorf("reqlog: could not parse Burp XML: %w", err)
+		}
+
+		result.Items++
+
+		warn := func(format string, a ...interface{}) {
+			result.Warnings = append(result.Warnings, ImportWarning{
+				Item:    result.Items,
+				Message: fmt.Sprintf(format, a...),
+			})
+		}
+
+		entry, err := parseBurpItem(item, warn)
+		if err != nil {
+			warn("skipped: %v", err)
+			continue
+		}
+
+		entries = append(entries, entry)
+
+		if len(entries) == burpImportBatchSize {
+			if err := flush(); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// parseBurpItem returns the import entry of a Burp item. Problems that don't
+// prevent the item from being imported are reported via warn.
+func parseBurpItem(item burpItem, warn func(format string, a ...interface{})) (ImportEntry, error) {
+	rawReq, err := item.Request.decode()
+	if err != nil {
+		return ImportEntry{}, fmt.Errorf("could not decode request: %w", err)
+	}
+
+	req, err := http.ReadRequest(bufio.NewReader(bytes.NewReader(burpHTTP2Proto(rawReq))))
+	if err != nil {
+		return ImportEntry{}, fmt.Errorf("could not parse request: %w", err)
+	}
+
+	reqBody, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return ImportEntry{}, fmt.Errorf("could not read request body: %w", err)
+	}
+
+	// The request line only has the path, the item has the absolute URL.
+	u, err := url.Parse(strings.TrimSpace(item.URL))
+	if err != nil {
+		return ImportEntry{}, fmt.Errorf("could not parse URL: %w", err)
+	}
+
+	req.URL = u
+	req.RequestURI = ""
+
+	if err := validateRequest(*req); err != nil {
+		return ImportEntry{}, err
+	}
+
+	timestamp, err := parseBurpTime(item.Time)
+	if err != nil {
+		warn("invalid time (%q), using the time of import: %v", item.Time, err)
+
+		timestamp = time.Now()
+	}
+
+	reqLog := Request{
+		Request:   *req,
+		Body:      reqBody,
+		Timestamp: timestamp,
+	}
+
+	if resLog, err := parseBurpResponse(item.Response, req); err != nil {
+		warn("response skipped: %v", err)
+	} else if resLog != nil {
+		resLog.Timestamp = timestamp
+		reqLog.Response = resLog
+	}
+
+	// Re-importing an export shouldn't duplicate its items, so the key is
+	// derived from the item itself.
+	hash := sha256.New()
+	for _, s := range []string{item.Time, item.URL, item.Request.Data, item.Response.Data} {
+		hash.Write([]byte(s))
+		hash.Write([]byte{0})
+	}
+
+	return ImportEntry{
+		Key:     "burp:" + hex.EncodeToString(hash.Sum(nil)),
+		Request: reqLog,
+	}, nil
+}
+
+// parseBurpTime parses the `time` field of a Burp item. Besides the
+// abbreviations in burpTimeZones, the time zone can be an offset from GMT, like
+// `GMT+05:30`, which Java uses for zones without an abbreviation.
+func parseBurpTime(s string) (time.Time, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 6 {
+		return time.Time{}, errors.New("unexpected format")
+	}
+
+	zone := fields[4]
+
+	offset, ok := burpTimeZones[zone]
+	if !ok {
+		gmtOffset, err := time.Parse("GMT-07:00", zone)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unknown time zone %q", zone)
+		}
+
+		_, offset = gmtOffset.Zone()
+	}
+
+	fields = append(fields[:4], fields[5])
+
+	return time.ParseInLocation(burpTimeLayout, strings.Join(fields, " "), time.FixedZone(zone, offset))
+}
+
+// parseBurpResponse returns the response log of a raw Burp response, or nil if
+// the item doesn't have a response (e.g. when the request failed).
+func parseBurpResponse(blob burpBlob, req *http.Request) (*Response, error) {
+	raw, err := blob.decode()
+	if err != nil {
+		return nil, fmt.Errorf("could not decode response: %w", err)
+	}
+
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return nil, nil
+	}
+
+	res, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(burpHTTP2Proto(raw))), req)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse response: %w", err)
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read response body: %w", err)
+	}
+
+	body, err = decodeGzipBody(res.Header, body, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{Response: *res, Body: body}, nil
+}
+
+// burpHTTP2Proto replaces the "HTTP/2" protocol version that Burp uses for
+// HTTP/2 messages with "HTTP/2.0", which can be parsed as HTTP/1 messages.
+func burpHTTP2Proto(raw []byte) []byte {
+	i := bytes.IndexByte(raw, '\n')
+	if i == -1 {
+		return raw
+	}
+
+	line := bytes.TrimRight(raw[:i], "\r")
+
+	switch {
+	case bytes.HasPrefix(line, []byte("HTTP/2 ")):
+		line = append([]byte("HTTP/2.0 "), line[len("HTTP/2 "):]...)
+	case bytes.HasSuffix(line, []byte(" HTTP/2")):
+		line = append(append([]byte{}, line...), ".0"...)
+	default:
+		return raw
+	}
+
+	return append(append(line, "\r\n"...), raw[i+1:]...)
+}
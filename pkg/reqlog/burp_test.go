@@ -0,0 +1,248 @@
+package reqlog_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+const burpItemTemplate = `<item>
+    <time>%v</time>
+    <url><![CDATA[%v]]></url>
+    <host ip="93.184.216.34">example.com</host>
+    <port>443</port>
+    <protocol>https</protocol>
+    <request base64="%v"><![CDATA[%v]]></request>
+    <status>200</status>
+    <response base64="%v"><![CDATA[%v]]></response>
+    <comment></comment>
+  </item>`
+
+func burpXML(items ...string) string {
+	return `<?xml version="1.0"?>
+<!DOCTYPE items [
+<!ELEMENT items (item*)>
+]>
+<items burpVersion="2023.1" exportTime="Mon Jan 02 15:04:05 UTC 2023">
+  ` + strings.Join(items, "\n  ") + `
+</items>`
+}
+
+func burpItem(timestamp, rawURL, req, res string, b64 bool) string {
+	if b64 {
+		req = base64.StdEncoding.EncodeToString([]byte(req))
+		res = base64.StdEncoding.EncodeToString([]byte(res))
+	}
+
+	return fmt.Sprintf(burpItemTemplate, timestamp, rawURL, b64, req, b64, res)
+}
+
+func TestImportBurp(t *testing.T) {
+	t.Parallel()
+
+	export := burpXML(
+		burpItem("Mon Jan 02 15:04:05 UTC 2023", "https://example.com/foo?bar=baz",
+			"GET /foo?bar=baz HTTP/1.1\r\nHost: example.com\r\nUser-Agent: burp\r\n\r\n",
+			"HTTP/1.1 200 OK\r\nContent-Type: text/plain\r\nContent-Length: 5\r\n\r\nhello",
+			true),
+		burpItem("Mon Jan 02 07:04:06 PST 2023", "https://example.com/api",
+			"POST /api HTTP/2\r\nHost: example.com\r\nContent-Type: application/json\r\nContent-Length: 13\r\n\r\n"+
+				`{"foo":"bar"}`,
+			"HTTP/2 201 Created\r\nContent-Length: 0\r\n\r\n",
+			false),
+	)
+
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	result, err := svc.ImportBurp(ctx, strings.NewReader(export))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Items != 2 || result.Imported != 2 || len(result.Warnings) != 0 {
+		t.Fatalf("expected 2 imported items without warnings, got: %+v", result)
+	}
+
+	reqLogs, err := svc.FindRequests(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reqLogs) != 2 {
+		t.Fatalf("expected 2 request logs, got: %v", len(reqLogs))
+	}
+
+	sort.Slice(reqLogs, func(i, j int) bool { return reqLogs[i].Timestamp.Before(reqLogs[j].Timestamp) })
+
+	tests := []struct {
+		method     string
+		url        string
+		proto      string
+		reqBody    string
+		timestamp  time.Time
+		statusCode int
+		resBody    string
+	}{
+		{
+			method:     http.MethodGet,
+			url:        "https://example.com/foo?bar=baz",
+			proto:      "HTTP/1.1",
+			timestamp:  time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC),
+			statusCode: http.StatusOK,
+			resBody:    "hello",
+		},
+		{
+			method:     http.MethodPost,
+			url:        "https://example.com/api",
+			proto:      "HTTP/2.0",
+			reqBody:    `{"foo":"bar"}`,
+			timestamp:  time.Date(2023, 1, 2, 15, 4, 6, 0, time.UTC),
+			statusCode: http.StatusCreated,
+		},
+	}
+
+	for i, tt := range tests {
+		got, err := svc.FindRequestLogByID(ctx, reqLogs[i].ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got.Request.Method != tt.method || got.Request.URL.String() != tt.url || got.Request.Proto != tt.proto {
+			t.Errorf("item %v: expected %v %v %v, got: %v %v %v", i+1, tt.method, tt.url, tt.proto,
+				got.Request.Method, got.Request.URL, got.Request.Proto)
+		}
+
+		if string(got.Body) != tt.reqBody {
+			t.Errorf("item %v: expected request body %q, got: %q", i+1, tt.reqBody, got.Body)
+		}
+
+		if !got.Timestamp.Equal(tt.timestamp) {
+			t.Errorf("item %v: expected timestamp %v, got: %v", i+1, tt.timestamp, got.Timestamp)
+		}
+
+		if got.Response == nil {
+			t.Fatalf("item %v: expected response log", i+1)
+		}
+
+		if got.Response.Response.StatusCode != tt.statusCode {
+			t.Errorf("item %v: expected status code %v, got: %v", i+1, tt.statusCode, got.Response.Response.StatusCode)
+		}
+
+		if string(got.Response.Body) != tt.resBody {
+			t.Errorf("item %v: expected response body %q, got: %q", i+1, tt.resBody, got.Response.Body)
+		}
+	}
+
+	// Importing the same export again doesn't duplicate its items.
+	result, err = svc.ImportBurp(ctx, strings.NewReader(export))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Imported != 0 {
+		t.Errorf("expected no items to be imported again, got: %v", result.Imported)
+	}
+}
+
+func TestImportBurpWarnings(t *testing.T) {
+	t.Parallel()
+
+	export := burpXML(
+		burpItem("Mon Jan 02 15:04:05 UTC 2023", "https://example.com/", "not base64", "", false),
+		`<item><url>https://example.com/</url><request base64="true">!!!</request></item>`,
+		burpItem("yesterday", "https://example.com/", "GET / HTTP/1.1\r\nHost: example.com\r\n\r\n", "", true),
+		burpItem("Mon Jan 02 15:04:05 XYZ 2023", "https://example.com/", "GET / HTTP/1.1\r\nHost: example.com\r\n\r\n", "", true),
+	)
+
+	svc, _ := newTestService(t)
+
+	result, err := svc.ImportBurp(context.Background(), strings.NewReader(export))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Items != 4 || result.Imported != 2 {
+		t.Errorf("expected 2 of 4 items to be imported, got: %+v", result)
+	}
+
+	var items []int
+	for _, warning := range result.Warnings {
+		items = append(items, warning.Item)
+	}
+
+	if exp := []int{1, 2, 3, 4}; fmt.Sprint(items) != fmt.Sprint(exp) {
+		t.Errorf("expected warnings for items %v, got: %v", exp, result.Warnings)
+	}
+}
+
+func TestImportBurpTimes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value   string
+		exp     time.Time
+		expWarn bool
+	}{
+		{value: "Mon Jan 02 15:04:05 UTC 2023", exp: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{value: "Mon Jan 02 16:04:05 CET 2023", exp: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{value: "Mon Jan 02 10:04:05 EST 2023", exp: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{value: "Mon Jan 02 20:34:05 GMT+05:30 2023", exp: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{value: "Mon Jan 02 15:04:05 XYZ 2023", expWarn: true},
+		{value: "Mon Jan 02 15:04:05 CST 2023", expWarn: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.value, func(t *testing.T) {
+			t.Parallel()
+
+			export := burpXML(burpItem(tt.value, "https://example.com/",
+				"GET / HTTP/1.1\r\nHost: example.com\r\n\r\n", "", false))
+
+			svc, _ := newTestService(t)
+			ctx := context.Background()
+
+			start := time.Now()
+
+			result, err := svc.ImportBurp(ctx, strings.NewReader(export))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.expWarn != (len(result.Warnings) == 1) {
+				t.Errorf("expected warning: %v, got: %v", tt.expWarn, result.Warnings)
+			}
+
+			reqLogs, err := svc.FindRequests(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(reqLogs) != 1 {
+				t.Fatalf("expected 1 request log, got: %v", len(reqLogs))
+			}
+
+			got := reqLogs[0].Timestamp
+
+			// Times with an unknown time zone get the time of import.
+			if tt.expWarn {
+				if got.Before(start.Truncate(time.Second)) {
+					t.Errorf("expected time of import, got: %v", got)
+				}
+
+				return
+			}
+
+			if !got.Equal(tt.exp) {
+				t.Errorf("expected timestamp %v, got: %v", tt.exp, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package reqlog
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// CacheStatus is whether a response was served from a cache, e.g. of a CDN.
+type CacheStatus string
+
+const (
+	CacheStatusHit     CacheStatus = "HIT"
+	CacheStatusMiss    CacheStatus = "MISS"
+	CacheStatusUnknown CacheStatus = "UNKNOWN"
+)
+
+// cacheStatusHeaders are headers that indicate a cache hit or miss, in order
+// of precedence.
+var cacheStatusHeaders = []string{
+	"CF-Cache-Status",
+	"Cache-Status",
+	"X-Cache-Status",
+	"X-Cache",
+}
+
+// ParseCacheStatus returns the cache status indicated by response headers. It
+// supports the `CF-Cache-Status` header of Cloudflare, the `Cache-Status` header
+// of RFC 9211, the `X-Cache-Status` header of nginx and the `X-Cache` header of
+// Varnish, CloudFront, Fastly and Akamai (e.g. "Hit from cloudfront" or
+// "TCP_MISS"). If none of these are set, a positive `Age` header indicates a
+// hit.
+func ParseCacheStatus(header http.Header) CacheStatus {
+	for _, key := range cacheStatusHeaders {
+		var status CacheStatus
+
+		if key == "Cache-Status" {
+			status = parseCacheStatusField(header.Values(key))
+		} else {
+			status = parseCacheStatusTokens(header.Values(key))
+		}
+
+		if status != CacheStatusUnknown {
+			return status
+		}
+	}
+
+	if age, err := strconv.ParseInt(strings.TrimSpace(header.Get("Age")), 10, 64); err == nil && age > 0 {
+		return CacheStatusHit
+	}
+
+	return CacheStatusUnknown
+}
+
+// parseCacheStatusTokens returns the cache status of header values with
+// hit/miss tokens. With multiple caches (e.g. "MISS, HIT"), a hit in any of
+// them is a hit.
+func parseCacheStatusTokens(values []string) CacheStatus {
+	status := CacheStatusUnknown
+
+	for _, value := range values {
+		tokens := strings.FieldsFunc(strings.ToUpper(value), func(r rune) bool {
+			return !unicode.IsLetter(r) && r != '_'
+		})
+
+		for _, token := range tokens {
+			switch {
+			case token == "HIT" || strings.HasSuffix(token, "_HIT") ||
+				token == "STALE" || token == "UPDATING" || token == "REVALIDATED":
+				return CacheStatusHit
+			case token == "MISS" || strings.HasSuffix(token, "_MISS") ||
+				token == "EXPIRED" || token == "BYPASS" || token == "DYNAMIC":
+				status = CacheStatusMiss
+			}
+		}
+	}
+
+	return status
+}
+
+// parseCacheStatusField returns the cache status of RFC 9211 `Cache-Status`
+// header values (e.g. "ExampleCache; hit" or "ExampleCache; fwd=uri-miss").
+// A cache with the `hit` parameter is a hit, one with a `fwd` parameter a
+// miss.
+func parseCacheStatusField(values []string) CacheStatus {
+	status := CacheStatusUnknown
+
+	for _, value := range values {
+		for _, cache := range strings.Split(value, ",") {
+			params := strings.Split(cache, ";")
+
+			for _, param := range params[1:] {
+				name := strings.ToLower(strings.TrimSpace(param))
+				if i := strings.Index(name, "="); i >= 0 {
+					name = strings.TrimSpace(name[:i])
+				}
+
+				switch name {
+				case "hit":
+					return CacheStatusHit
+				case "fwd":
+					status = CacheStatusMiss
+				}
+			}
+		}
+	}
+
+	return status
+}
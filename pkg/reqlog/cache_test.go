@@ -0,0 +1,89 @@
+package reqlog_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestParseCacheStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		header http.Header
+		exp    reqlog.CacheStatus
+	}{
+		{name: "x-cache hit", header: http.Header{"X-Cache": {"HIT"}}, exp: reqlog.CacheStatusHit},
+		{name: "x-cache miss", header: http.Header{"X-Cache": {"MISS"}}, exp: reqlog.CacheStatusMiss},
+		{name: "cloudfront", header: http.Header{"X-Cache": {"Hit from cloudfront"}}, exp: reqlog.CacheStatusHit},
+		{name: "akamai", header: http.Header{"X-Cache": {"TCP_MISS from a23-1-2-3"}}, exp: reqlog.CacheStatusMiss},
+		{name: "fastly shield miss", header: http.Header{"X-Cache": {"MISS, HIT"}}, exp: reqlog.CacheStatusHit},
+		{name: "cloudflare dynamic", header: http.Header{"Cf-Cache-Status": {"DYNAMIC"}}, exp: reqlog.CacheStatusMiss},
+		{name: "cloudflare stale", header: http.Header{"Cf-Cache-Status": {"STALE"}}, exp: reqlog.CacheStatusHit},
+		{name: "nginx", header: http.Header{"X-Cache-Status": {"EXPIRED"}}, exp: reqlog.CacheStatusMiss},
+		{name: "rfc 9211 hit", header: http.Header{"Cache-Status": {"ExampleCache; hit; ttl=30"}}, exp: reqlog.CacheStatusHit},
+		{
+			name:   "rfc 9211 miss",
+			header: http.Header{"Cache-Status": {"ExampleCache; fwd=uri-miss"}},
+			exp:    reqlog.CacheStatusMiss,
+		},
+		{name: "age", header: http.Header{"Age": {"120"}}, exp: reqlog.CacheStatusHit},
+		{name: "zero age", header: http.Header{"Age": {"0"}}, exp: reqlog.CacheStatusUnknown},
+		{
+			name:   "header takes precedence over age",
+			header: http.Header{"X-Cache": {"MISS"}, "Age": {"120"}},
+			exp:    reqlog.CacheStatusMiss,
+		},
+		{name: "no indicators", header: http.Header{}, exp: reqlog.CacheStatusUnknown},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := reqlog.ParseCacheStatus(tt.header); got != tt.exp {
+				t.Errorf("expected cache status %v, got: %v", tt.exp, got)
+			}
+		})
+	}
+}
+
+func TestAddResponseLogCacheStatus(t *testing.T) {
+	t.Parallel()
+
+	_, db := newTestService(t)
+	ctx := context.Background()
+
+	addReqLog := func(header http.Header) int64 {
+		return addTestRequestLog(t, db, reqlog.Request{
+			Request:  *httptest.NewRequest(http.MethodGet, "https://example.com/", nil),
+			Response: &reqlog.Response{Response: http.Response{StatusCode: http.StatusOK, Header: header}},
+		}).ID
+	}
+
+	hit := addReqLog(http.Header{"X-Cache": {"HIT"}})
+	addReqLog(http.Header{})
+
+	reqLog, err := db.FindRequestLogByID(ctx, hit)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if reqLog.Response.CacheStatus != reqlog.CacheStatusHit {
+		t.Errorf("expected cache status %v, got: %v", reqlog.CacheStatusHit, reqLog.Response.CacheStatus)
+	}
+
+	reqLogs, err := db.FindRequestLogs(ctx, reqlog.FindRequestsFilter{CacheStatus: reqlog.CacheStatusHit}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reqLogs) != 1 || reqLogs[0].ID != hit {
+		t.Errorf("expected only request log %v to match cache status filter, got: %v", hit, len(reqLogs))
+	}
+}
@@ -0,0 +1,64 @@
+package reqlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var ErrInvalidRequest = errors.New("reqlog: invalid request")
+
+// CreateRequest stores a user-authored request log, without sending the
+// request. It can be sent later on using ResendRequest. The method must be a
+// valid token, and the URL must be absolute, with an `http` or `https` scheme.
+func (svc *Service) CreateRequest(ctx context.Context, req http.Request, body []byte) (*Request, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	if req.Proto == "" {
+		req.Proto, req.ProtoMajor, req.ProtoMinor = "HTTP/1.1", 1, 1
+	}
+
+	if req.Header == nil {
+		req.Header = make(http.Header)
+	}
+
+	return svc.repo.AddRequestLog(ctx, Request{Request: req, Body: body, Timestamp: time.Now()})
+}
+
+func validateRequest(req http.Request) error {
+	if !isToken(req.Method) {
+		return fmt.Errorf("%w: invalid method (%q)", ErrInvalidRequest, req.Method)
+	}
+
+	if req.URL == nil || req.URL.Host == "" {
+		return fmt.Errorf("%w: URL must be absolute", ErrInvalidRequest)
+	}
+
+	if scheme := strings.ToLower(req.URL.Scheme); scheme != "http" && scheme != "https" {
+		return fmt.Errorf("%w: unsupported URL scheme (%q)", ErrInvalidRequest, req.URL.Scheme)
+	}
+
+	return nil
+}
+
+// isToken reports whether s is a valid token, as defined in RFC 7230, section
+// 3.2.6. Methods must be tokens.
+func isToken(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if r >= 0x80 || !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' ||
+			strings.ContainsRune("!#$%&'*+-.^_`|~", r)) {
+			return false
+		}
+	}
+
+	return true
+}
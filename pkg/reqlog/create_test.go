@@ -0,0 +1,226 @@
+package reqlog_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestCreateRequest(t *testing.T) {
+	t.Parallel()
+
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	u, _ := url.Parse("https://example.com/foo?bar=baz")
+	header := http.Header{
+		"X-Foo":        []string{"bar", "baz"},
+		"Content-Type": []string{"application/json"},
+	}
+
+	created, err := svc.CreateRequest(ctx, http.Request{Method: "PATCH", URL: u, Header: header}, []byte(`{"foo":"bar"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := svc.FindRequestLogByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.Request.Method != "PATCH" {
+		t.Errorf("expected method %q, got: %q", "PATCH", got.Request.Method)
+	}
+
+	if got.Request.URL.String() != u.String() {
+		t.Errorf("expected URL %q, got: %q", u, got.Request.URL)
+	}
+
+	if got.Request.Proto != "HTTP/1.1" {
+		t.Errorf("expected default proto %q, got: %q", "HTTP/1.1", got.Request.Proto)
+	}
+
+	if !reflect.DeepEqual(header, got.Request.Header) {
+		t.Errorf("expected headers %v, got: %v", header, got.Request.Header)
+	}
+
+	if exp := `{"foo":"bar"}`; string(got.Body) != exp {
+		t.Errorf("expected body %q, got: %q", exp, got.Body)
+	}
+
+	if got.Response != nil {
+		t.Errorf("expected no response, got: %+v", got.Response)
+	}
+}
+
+func TestCreateRequestValidation(t *testing.T) {
+	t.Parallel()
+
+	svc, _ := newTestService(t)
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+	}{
+		{name: "empty method", method: "", url: "https://example.com/"},
+		{name: "method with space", method: "GE T", url: "https://example.com/"},
+		{name: "relative URL", method: http.MethodGet, url: "/foo"},
+		{name: "unsupported scheme", method: http.MethodGet, url: "ftp://example.com/"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			u, err := url.Parse(tt.url)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			_, err = svc.CreateRequest(context.Background(), http.Request{Method: tt.method, URL: u}, nil)
+			if !errors.Is(err, reqlog.ErrInvalidRequest) {
+				t.Errorf("expected error %v, got: %v", reqlog.ErrInvalidRequest, err)
+			}
+		})
+	}
+}
+
+func TestResendRequest(t *testing.T) {
+	t.Parallel()
+
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("X-Echo", r.Header.Get("X-Foo"))
+		w.WriteHeader(http.StatusCreated)
+		w.Write(body)
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL + "/foo")
+
+	created, err := svc.CreateRequest(ctx, http.Request{
+		Method: http.MethodPost,
+		URL:    u,
+		Header: http.Header{"X-Foo": []string{"bar"}},
+	}, []byte("foobar"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resent, err := svc.ResendRequest(ctx, created.ID, reqlog.ResendOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resent.ID == created.ID {
+		t.Error("expected resent request to be stored as a new request log")
+	}
+
+	got, err := svc.FindRequestLogByID(ctx, resent.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.Response == nil {
+		t.Fatal("expected response log")
+	}
+
+	if got.Response.Response.StatusCode != http.StatusCreated {
+		t.Errorf("expected status code %v, got: %v", http.StatusCreated, got.Response.Response.StatusCode)
+	}
+
+	if got.Response.Response.Header.Get("X-Echo") != "bar" {
+		t.Errorf("expected request headers to be resent, got response headers: %v", got.Response.Response.Header)
+	}
+
+	if string(got.Response.Body) != "foobar" {
+		t.Errorf("expected request body to be resent, got response body: %q", got.Response.Body)
+	}
+
+	if _, err := svc.ResendRequest(ctx, 42, reqlog.ResendOptions{}); !errors.Is(err, reqlog.ErrRequestNotFound) {
+		t.Errorf("expected error %v, got: %v", reqlog.ErrRequestNotFound, err)
+	}
+}
+
+func TestResendRequestOptions(t *testing.T) {
+	t.Parallel()
+
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-User-Agent", r.UserAgent())
+		w.Header().Set("X-If-None-Match", r.Header.Get("If-None-Match"))
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL)
+
+	created, err := svc.CreateRequest(ctx, http.Request{
+		Method: http.MethodGet,
+		URL:    u,
+		Header: http.Header{
+			"User-Agent":    []string{"foo/1.0"},
+			"If-None-Match": []string{`"abc"`},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Run("override and remove headers", func(t *testing.T) {
+		resent, err := svc.ResendRequest(ctx, created.ID, reqlog.ResendOptions{
+			SetHeaders:    http.Header{"User-Agent": []string{"bar/2.0"}},
+			RemoveHeaders: []string{"if-none-match"},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got, err := svc.FindRequestLogByID(ctx, resent.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if ua := got.Request.Header.Get("User-Agent"); ua != "bar/2.0" {
+			t.Errorf("expected overridden user agent to be logged, got: %q", ua)
+		}
+
+		if values := got.Request.Header.Values("If-None-Match"); len(values) != 0 {
+			t.Errorf("expected removed header not to be logged, got: %v", values)
+		}
+
+		if got.Response == nil {
+			t.Fatal("expected response log")
+		}
+
+		if ua := got.Response.Response.Header.Get("X-User-Agent"); ua != "bar/2.0" {
+			t.Errorf("expected overridden user agent to be sent, got: %q", ua)
+		}
+
+		if v := got.Response.Response.Header.Get("X-If-None-Match"); v != "" {
+			t.Errorf("expected removed header not to be sent, got: %q", v)
+		}
+	})
+
+	t.Run("header injection", func(t *testing.T) {
+		_, err := svc.ResendRequest(ctx, created.ID, reqlog.ResendOptions{
+			SetHeaders: http.Header{"User-Agent": []string{"bar\r\nX-Injected: true"}},
+		})
+		if !errors.Is(err, reqlog.ErrInvalidRequest) {
+			t.Errorf("expected error %v, got: %v", reqlog.ErrInvalidRequest, err)
+		}
+	})
+}
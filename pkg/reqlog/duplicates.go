@@ -0,0 +1,28 @@
+package reqlog
+
+import "context"
+
+// DuplicateRequests is a set of request logs with the same method, URL and
+// body.
+type DuplicateRequests struct {
+	Fingerprint string
+	RequestIDs  []int64
+}
+
+// FindDuplicates returns all sets of duplicate request logs, ordered by request
+// log ID within each set.
+func (svc *Service) FindDuplicates(ctx context.Context) ([]DuplicateRequests, error) {
+	return svc.repo.FindDuplicateRequestLogs(ctx)
+}
+
+// DuplicateCount returns the number of redundant request logs, i.e. excluding
+// the first request log of each set of duplicates.
+func (svc *Service) DuplicateCount(ctx context.Context) (int64, error) {
+	return svc.repo.CountDuplicateRequestLogs(ctx)
+}
+
+// DeleteDuplicates deletes all but the first request log of each set of
+// duplicates, and returns the number of deleted request logs.
+func (svc *Service) DeleteDuplicates(ctx context.Context) (int64, error) {
+	return svc.repo.DeleteDuplicateRequestLogs(ctx)
+}
@@ -0,0 +1,71 @@
+package reqlog_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	var ids []int64
+
+	for _, body := range []string{"foo", "foo", "foo", "bar"} {
+		req := httptest.NewRequest(http.MethodPost, "https://example.com/", nil)
+		ids = append(ids, addTestRequestLog(t, db, reqlog.Request{Request: *req, Body: []byte(body)}).ID)
+	}
+
+	clusters, err := svc.FindDuplicates(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(clusters) != 1 {
+		t.Fatalf("expected 1 set of duplicates, got: %v", len(clusters))
+	}
+
+	if exp := ids[:3]; !reflect.DeepEqual(exp, clusters[0].RequestIDs) {
+		t.Errorf("expected request IDs %v, got: %v", exp, clusters[0].RequestIDs)
+	}
+
+	count, err := svc.DuplicateCount(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if count != 2 {
+		t.Errorf("expected duplicate count 2, got: %v", count)
+	}
+
+	deleted, err := svc.DeleteDuplicates(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if deleted != 2 {
+		t.Errorf("expected 2 deleted request logs, got: %v", deleted)
+	}
+
+	for _, id := range []int64{ids[0], ids[3]} {
+		if _, err := svc.FindRequestLogByID(ctx, id); err != nil {
+			t.Errorf("expected request log %v to be kept, got error: %v", id, err)
+		}
+	}
+
+	count, err = svc.DuplicateCount(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if count != 0 {
+		t.Errorf("expected duplicate count 0 after deletion, got: %v", count)
+	}
+}
@@ -0,0 +1,60 @@
+package reqlog
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// LogsVersion is the state of the stored request logs, for detecting new,
+// deleted and updated request logs.
+type LogsVersion struct {
+	MaxRequestID  int64
+	MaxResponseID int64
+	Count         int64
+	LastReadID    int64
+	// Changes is the number of updates of existing request logs, like tags,
+	// stars and metadata.
+	Changes int64
+}
+
+// ETag returns an entity tag for the request logs matching a filter. It changes
+// when request logs are added, deleted or updated, when responses are added,
+// when the filter or scope changes, or when another project is opened. It's
+// quoted, for use in an `ETag` header.
+func (svc *Service) ETag(ctx context.Context, filter FindRequestsFilter) (string, error) {
+	version, err := svc.repo.FindLogsVersion(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	v := struct {
+		Project string
+		Version LogsVersion
+		Filter  FindRequestsFilter
+		Scope   interface{}
+	}{
+		Version: version,
+		Filter:  filter,
+	}
+
+	// Request logs of different projects can have the same version.
+	if project, err := svc.projService.ActiveProject(); err == nil {
+		v.Project = project.Name
+	}
+
+	if svc.scope != nil {
+		v.Scope = svc.scope.Rules()
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("reqlog: could not encode ETag input: %w", err)
+	}
+
+	sum := sha256.Sum256(b)
+
+	return `"` + hex.EncodeToString(sum[:16]) + `"`, nil
+}
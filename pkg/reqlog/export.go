@@ -0,0 +1,120 @@
+package reqlog
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+	"unicode/utf8"
+)
+
+const bodyEncodingBase64 = "base64"
+
+type exportedHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type exportedResponse struct {
+	ID           int64            `json:"id"`
+	Proto        string           `json:"proto"`
+	StatusCode   int              `json:"statusCode"`
+	Status       string           `json:"status"`
+	Headers      []exportedHeader `json:"headers"`
+	Body         string           `json:"body,omitempty"`
+	BodyEncoding string           `json:"bodyEncoding,omitempty"`
+	Timestamp    time.Time        `json:"timestamp"`
+}
+
+type exportedRequest struct {
+	ID           int64             `json:"id"`
+	Method       string            `json:"method"`
+	URL          string            `json:"url"`
+	Proto        string            `json:"proto"`
+	Headers      []exportedHeader  `json:"headers"`
+	Body         string            `json:"body,omitempty"`
+	BodyEncoding string            `json:"bodyEncoding,omitempty"`
+	Timestamp    time.Time         `json:"timestamp"`
+	Response     *exportedResponse `json:"response,omitempty"`
+}
+
+// ExportNDJSON writes all request logs matching the filter to w as newline
+// delimited JSON, one log per line. Bodies that aren't valid UTF-8 are base64
+// encoded, which is indicated by a `bodyEncoding` field.
+func (svc *Service) ExportNDJSON(ctx context.Context, filter FindRequestsFilter, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+
+	err := svc.repo.StreamRequestLogs(ctx, filter, svc.scope, func(req Request) error {
+		if err := enc.Encode(newExportedRequest(req)); err != nil {
+			return fmt.Errorf("reqlog: could not encode request log: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("reqlog: could not flush writer: %w", err)
+	}
+
+	return nil
+}
+
+func newExportedRequest(req Request) exportedRequest {
+	exp := exportedRequest{
+		ID:        req.ID,
+		Method:    req.Request.Method,
+		Proto:     req.Request.Proto,
+		Headers:   make([]exportedHeader, 0),
+		Timestamp: req.Timestamp,
+	}
+
+	if req.Request.URL != nil {
+		exp.URL = req.Request.URL.String()
+	}
+
+	exp.Body, exp.BodyEncoding = exportBody(req.Body)
+
+	for key, values := range req.Request.Header {
+		for _, value := range values {
+			exp.Headers = append(exp.Headers, exportedHeader{Key: key, Value: value})
+		}
+	}
+
+	if req.Response != nil {
+		res := &exportedResponse{
+			ID:         req.Response.ID,
+			Proto:      req.Response.Response.Proto,
+			StatusCode: req.Response.Response.StatusCode,
+			Status:     req.Response.Response.Status,
+			Headers:    make([]exportedHeader, 0),
+			Timestamp:  req.Response.Timestamp,
+		}
+
+		res.Body, res.BodyEncoding = exportBody(req.Response.Body)
+
+		for key, values := range req.Response.Response.Header {
+			for _, value := range values {
+				res.Headers = append(res.Headers, exportedHeader{Key: key, Value: value})
+			}
+		}
+
+		exp.Response = res
+	}
+
+	return exp
+}
+
+func exportBody(body []byte) (string, string) {
+	if utf8.Valid(body) {
+		return string(body), ""
+	}
+
+	return base64.StdEncoding.EncodeToString(body), bodyEncodingBase64
+}
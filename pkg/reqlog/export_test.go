@@ -0,0 +1,84 @@
+package reqlog_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestExportNDJSON(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	const n = 5
+
+	for i := 0; i < n; i++ {
+		req := httptest.NewRequest(http.MethodPost, "https://example.com/foo", nil)
+		req.Header.Set("X-Foo", "bar")
+
+		res := http.Response{
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+			Proto:      "HTTP/1.1",
+			Header:     http.Header{"Content-Type": []string{"text/plain"}},
+		}
+
+		addTestRequestLog(t, db, reqlog.Request{
+			Request:  *req,
+			Body:     []byte{0xff, 0x00, byte(i)},
+			Response: &reqlog.Response{Response: res, Body: []byte("hello")},
+		})
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := svc.ExportNDJSON(ctx, reqlog.FindRequestsFilter{}, buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var lines int
+
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		lines++
+
+		var got struct {
+			Method       string
+			BodyEncoding string
+			Headers      []struct{ Key, Value string }
+			Response     struct{ StatusCode int }
+		}
+
+		if err := json.Unmarshal(scanner.Bytes(), &got); err != nil {
+			t.Fatalf("line %v is not valid JSON: %v", lines, err)
+		}
+
+		if got.Method != http.MethodPost {
+			t.Errorf("expected method %q, got: %q", http.MethodPost, got.Method)
+		}
+
+		if got.BodyEncoding != "base64" {
+			t.Errorf("expected binary body to be base64 encoded, got encoding: %q", got.BodyEncoding)
+		}
+
+		if len(got.Headers) != 1 {
+			t.Errorf("expected 1 request header, got: %v", len(got.Headers))
+		}
+
+		if got.Response.StatusCode != http.StatusOK {
+			t.Errorf("expected response status code %v, got: %v", http.StatusOK, got.Response.StatusCode)
+		}
+	}
+
+	if lines != n {
+		t.Errorf("expected %v lines, got: %v", n, lines)
+	}
+}
@@ -0,0 +1,124 @@
+package reqlog
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxExportFilenamePathLength is the maximum length of the part of a zip entry
+// filename that's derived from the URL path.
+const maxExportFilenamePathLength = 100
+
+// ExportHTTPZip writes all request logs matching the filter to w as a zip
+// archive, with an `.http` file per request log. Each file has the
+// reconstructed request (see `RawRequest`) and, if it has one, a line break, a
+// blank line and the reconstructed response (see `RawResponse`). The archive
+// is written while request logs are read, so it isn't buffered in memory.
+func (svc *Service) ExportHTTPZip(ctx context.Context, filter FindRequestsFilter, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	zw := zip.NewWriter(bw)
+
+	err := svc.repo.StreamRequestLogs(ctx, filter, svc.scope, func(req Request) error {
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     exportFilename(req),
+			Method:   zip.Deflate,
+			Modified: req.Timestamp,
+		})
+		if err != nil {
+			return fmt.Errorf("reqlog: could not create zip entry: %w", err)
+		}
+
+		if _, err := fw.Write(RawRequest(req)); err != nil {
+			return fmt.Errorf("reqlog: could not write request: %w", err)
+		}
+
+		if req.Response == nil {
+			return nil
+		}
+
+		if _, err := io.WriteString(fw, "\r\n\r\n"); err != nil {
+			return fmt.Errorf("reqlog: could not write response: %w", err)
+		}
+
+		if _, err := fw.Write(RawResponse(*req.Response)); err != nil {
+			return fmt.Errorf("reqlog: could not write response: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("reqlog: could not close zip writer: %w", err)
+	}
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("reqlog: could not flush writer: %w", err)
+	}
+
+	return nil
+}
+
+// exportFilename returns the name of the zip entry of a request log, e.g.
+// "42_example.com_api_users.http". The host and path are sanitized, so names
+// only contain letters, digits, dots, hyphens and underscores, and can't
+// escape the directory the archive is extracted to.
+func exportFilename(req Request) string {
+	var host, path string
+
+	if req.Request.URL != nil {
+		host = req.Request.URL.Hostname()
+		path = req.Request.URL.Path
+	}
+
+	if host == "" {
+		host = req.Request.Host
+	}
+
+	name := fmt.Sprintf("%d", req.ID)
+
+	if host = sanitizeFilename(host); host != "" {
+		name += "_" + host
+	}
+
+	path = sanitizeFilename(path)
+	if len(path) > maxExportFilenamePathLength {
+		path = path[:maxExportFilenamePathLength]
+	}
+
+	if path = strings.Trim(path, "_."); path != "" {
+		name += "_" + path
+	}
+
+	return name + ".http"
+}
+
+// sanitizeFilename replaces runs of characters other than ASCII letters,
+// digits, dots, hyphens and underscores by a single underscore, and trims
+// leading and trailing underscores and dots.
+func sanitizeFilename(s string) string {
+	var b strings.Builder
+
+	replaced := false
+
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-':
+			b.WriteRune(r)
+
+			replaced = false
+		case !replaced:
+			b.WriteByte('_')
+
+			replaced = true
+		}
+	}
+
+	return strings.Trim(b.String(), "_.")
+}
@@ -0,0 +1,125 @@
+package reqlog_test
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestExportHTTPZip(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	addReqLog := func(url string, withResponse bool) int64 {
+		req := httptest.NewRequest(http.MethodPost, url, nil)
+		req.Header.Set("Content-Length", "5")
+
+		reqLog := reqlog.Request{Request: *req, Body: []byte("hello")}
+
+		if withResponse {
+			res := http.Response{
+				Status:     "201 Created",
+				StatusCode: http.StatusCreated,
+				Proto:      "HTTP/1.1",
+				Header:     http.Header{"Content-Length": []string{"2"}},
+			}
+
+			reqLog.Response = &reqlog.Response{Response: res, Body: []byte("ok")}
+		}
+
+		return addTestRequestLog(t, db, reqLog).ID
+	}
+
+	first := addReqLog("https://example.com/api/users?id=1", true)
+	second := addReqLog("https://example.com:8443/api/../../etc/passwd", false)
+	addReqLog("https://example.com/other", true)
+
+	buf := &bytes.Buffer{}
+
+	if err := svc.ExportHTTPZip(ctx, reqlog.FindRequestsFilter{PathPrefix: "/api"}, buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("could not read zip: %v", err)
+	}
+
+	files := make(map[string]*zip.File)
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	expNames := []string{
+		fmt.Sprintf("%d_example.com_api_users.http", first),
+		fmt.Sprintf("%d_example.com_api_.._.._etc_passwd.http", second),
+	}
+
+	if len(files) != len(expNames) {
+		t.Fatalf("expected %v entries, got: %v", len(expNames), len(files))
+	}
+
+	for _, name := range expNames {
+		if _, ok := files[name]; !ok {
+			t.Errorf("expected entry %q, got: %v", name, zr.File)
+		}
+	}
+
+	rc, err := files[expNames[0]].Open()
+	if err != nil {
+		t.Fatalf("could not open entry: %v", err)
+	}
+	defer rc.Close()
+
+	content, err := ioutil.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("could not read entry: %v", err)
+	}
+
+	br := bufio.NewReader(bytes.NewReader(content))
+
+	req, err := http.ReadRequest(br)
+	if err != nil {
+		t.Fatalf("could not parse request: %v", err)
+	}
+
+	reqBody, _ := ioutil.ReadAll(req.Body)
+	if req.Method != http.MethodPost || req.RequestURI != "/api/users?id=1" || req.Host != "example.com" ||
+		string(reqBody) != "hello" {
+		t.Errorf("unexpected request: %v %v (host: %v), body: %q", req.Method, req.RequestURI, req.Host, reqBody)
+	}
+
+	// The request and response are separated by a blank line.
+	sep := make([]byte, 4)
+	if _, err := io.ReadFull(br, sep); err != nil || string(sep) != "\r\n\r\n" {
+		t.Fatalf("expected blank line after request, got: %q", sep)
+	}
+
+	res, err := http.ReadResponse(br, req)
+	if err != nil {
+		t.Fatalf("could not parse response: %v", err)
+	}
+
+	resBody, _ := ioutil.ReadAll(res.Body)
+	if res.StatusCode != http.StatusCreated || string(resBody) != "ok" {
+		t.Errorf("unexpected response: %v, body: %q", res.Status, resBody)
+	}
+
+	for name := range files {
+		if strings.ContainsAny(name, `/\`) {
+			t.Errorf("expected entry name without path separators, got: %q", name)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package reqlog
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// GRPCMethod returns the full gRPC method (e.g. "helloworld.Greeter/SayHello")
+// of a request, or an empty string if it's not a gRPC request. A request is a
+// gRPC request if its content type is `application/grpc` (optionally with a
+// codec suffix, e.g. `application/grpc+proto`), and its path is in the format
+// "/{service}/{method}".
+func GRPCMethod(req http.Request) string {
+	mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil {
+		return ""
+	}
+
+	if mediaType != "application/grpc" && !strings.HasPrefix(mediaType, "application/grpc+") {
+		return ""
+	}
+
+	if req.URL == nil {
+		return ""
+	}
+
+	method := strings.TrimPrefix(req.URL.Path, "/")
+
+	parts := strings.Split(method, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return ""
+	}
+
+	return method
+}
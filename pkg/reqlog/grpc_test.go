@@ -0,0 +1,92 @@
+package reqlog_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestGRPCMethod(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		path        string
+		contentType string
+		exp         string
+	}{
+		{
+			name:        "grpc request",
+			path:        "/helloworld.Greeter/SayHello",
+			contentType: "application/grpc",
+			exp:         "helloworld.Greeter/SayHello",
+		},
+		{
+			name:        "grpc request with codec",
+			path:        "/helloworld.Greeter/SayHello",
+			contentType: "application/grpc+proto",
+			exp:         "helloworld.Greeter/SayHello",
+		},
+		{
+			name:        "non-grpc content type",
+			path:        "/helloworld.Greeter/SayHello",
+			contentType: "application/json",
+		},
+		{
+			name:        "grpc-web content type",
+			path:        "/helloworld.Greeter/SayHello",
+			contentType: "application/grpc-web",
+		},
+		{
+			name:        "invalid path",
+			path:        "/helloworld.Greeter/SayHello/foo",
+			contentType: "application/grpc",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPost, "https://example.com"+tt.path, nil)
+			req.Header.Set("Content-Type", tt.contentType)
+
+			if got := reqlog.GRPCMethod(*req); got != tt.exp {
+				t.Errorf("expected gRPC method %q, got: %q", tt.exp, got)
+			}
+		})
+	}
+}
+
+func TestAddRequestLogGRPCMethod(t *testing.T) {
+	t.Parallel()
+
+	_, db := newTestService(t)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/helloworld.Greeter/SayHello", nil)
+	req.Header.Set("Content-Type", "application/grpc")
+
+	reqLog, err := db.AddRequestLog(ctx, reqlog.Request{
+		Request:   *req,
+		Body:      []byte{0, 0, 0, 0, 2, 0x0a, 0x00},
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := db.FindRequestLogByID(ctx, reqLog.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if exp := "helloworld.Greeter/SayHello"; got.GRPCMethod != exp {
+		t.Errorf("expected gRPC method %q, got: %q", exp, got.GRPCMethod)
+	}
+}
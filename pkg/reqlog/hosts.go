@@ -0,0 +1,27 @@
+package reqlog
+
+import "context"
+
+const (
+	defaultTopHostsLimit = 10
+	maxTopHostsLimit     = 100
+)
+
+// HostCount is the number of request logs for a host.
+type HostCount struct {
+	Host  string
+	Count int64
+}
+
+// TopHosts returns the hosts with the most request logs matching a filter. The
+// limit defaults to 10 if it's not positive, and is capped at 100.
+func (svc *Service) TopHosts(ctx context.Context, filter FindRequestsFilter, limit int) ([]HostCount, error) {
+	switch {
+	case limit <= 0:
+		limit = defaultTopHostsLimit
+	case limit > maxTopHostsLimit:
+		limit = maxTopHostsLimit
+	}
+
+	return svc.repo.FindTopHosts(ctx, filter, svc.scope, limit)
+}
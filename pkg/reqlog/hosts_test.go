@@ -0,0 +1,81 @@
+package reqlog_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestTopHosts(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	seed := map[string]int{
+		"https://a.example.com/":      3,
+		"https://b.example.com/foo":   5,
+		"https://c.example.com:8443/": 1,
+		"https://D.example.com/":      3,
+	}
+
+	for url, n := range seed {
+		for i := 0; i < n; i++ {
+			addTestRequestLog(t, db, reqlog.Request{Request: *httptest.NewRequest(http.MethodGet, url, nil)})
+		}
+	}
+
+	tests := []struct {
+		name  string
+		limit int
+		exp   []reqlog.HostCount
+	}{
+		{
+			name:  "default limit",
+			limit: 0,
+			exp: []reqlog.HostCount{
+				{Host: "b.example.com", Count: 5},
+				{Host: "a.example.com", Count: 3},
+				{Host: "d.example.com", Count: 3},
+				{Host: "c.example.com:8443", Count: 1},
+			},
+		},
+		{
+			name:  "limited",
+			limit: 2,
+			exp: []reqlog.HostCount{
+				{Host: "b.example.com", Count: 5},
+				{Host: "a.example.com", Count: 3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := svc.TopHosts(ctx, reqlog.FindRequestsFilter{}, tt.limit)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.exp, got) {
+				t.Errorf("expected top hosts %+v, got: %+v", tt.exp, got)
+			}
+		})
+	}
+
+	got, err := svc.TopHosts(ctx, reqlog.FindRequestsFilter{PathPrefix: "/foo"}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if exp := []reqlog.HostCount{{Host: "b.example.com", Count: 5}}; !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected filtered top hosts %+v, got: %+v", exp, got)
+	}
+}
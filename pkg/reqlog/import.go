@@ -0,0 +1,44 @@
+package reqlog
+
+import (
+	"context"
+	"fmt"
+)
+
+// ImportRequest stores a request log from an external source (e.g. a HAR file
+// or a replay), including its response log if it has one. Timestamps of the
+// request and response logs are kept.
+//
+// Imports are idempotent per key: if key isn't empty, and a request log with
+// the same key was imported before, nothing is stored and the existing request
+// log is returned. Importers should derive the key from the source entry, so
+// re-running an import doesn't duplicate request logs. It reports whether the
+// request log was newly inserted.
+func (svc *Service) ImportRequest(ctx context.Context, key string, reqLog Request) (*Request, bool, error) {
+	if err := validateRequest(reqLog.Request); err != nil {
+		return nil, false, err
+	}
+
+	return svc.repo.ImportRequestLog(ctx, key, reqLog)
+}
+
+// ImportEntry is a request log to import, with its idempotency key.
+type ImportEntry struct {
+	Key     string
+	Request Request
+}
+
+// BulkImportRequests stores request logs from an external source, like
+// ImportRequest, but all in a single transaction that's optimized for large
+// imports (e.g. HAR files with thousands of entries). If any entry is invalid,
+// or can't be stored, nothing is stored. It returns the number of inserted
+// request logs.
+func (svc *Service) BulkImportRequests(ctx context.Context, entries []ImportEntry) (int, error) {
+	for i, entry := range entries {
+		if err := validateRequest(entry.Request.Request); err != nil {
+			return 0, fmt.Errorf("reqlog: could not import entry %v: %w", i, err)
+		}
+	}
+
+	return svc.repo.BulkImportRequestLogs(ctx, entries)
+}
@@ -0,0 +1,170 @@
+package reqlog_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestImportRequest(t *testing.T) {
+	t.Parallel()
+
+	// Entries of an import source, e.g. a HAR file, keyed by their position.
+	entries := make([]reqlog.Request, 3)
+	for i := range entries {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("https://example.com/%v", i), nil)
+		req.Header.Set("X-Foo", "bar")
+
+		timestamp := time.Date(2021, 1, 1, 0, 0, i, 0, time.UTC)
+
+		entries[i] = reqlog.Request{
+			Request:   *req,
+			Timestamp: timestamp,
+			Response: &reqlog.Response{
+				Response: http.Response{
+					Status:     "200 OK",
+					StatusCode: http.StatusOK,
+					Proto:      "HTTP/1.1",
+					Header:     http.Header{"Content-Type": []string{"text/plain"}},
+				},
+				Body:      []byte("foobar"),
+				Timestamp: timestamp.Add(50 * time.Millisecond),
+			},
+		}
+	}
+
+	importAll := func(t *testing.T, svc *reqlog.Service, keyed bool) (ids []int64, inserted int) {
+		t.Helper()
+
+		for i, entry := range entries {
+			var key string
+			if keyed {
+				key = fmt.Sprintf("example.har#%v", i)
+			}
+
+			reqLog, ok, err := svc.ImportRequest(context.Background(), key, entry)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if ok {
+				inserted++
+			}
+
+			ids = append(ids, reqLog.ID)
+		}
+
+		return ids, inserted
+	}
+
+	countRequestLogs := func(t *testing.T, svc *reqlog.Service) int {
+		t.Helper()
+
+		reqLogs, err := svc.FindRequests(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		return len(reqLogs)
+	}
+
+	t.Run("with idempotency keys", func(t *testing.T) {
+		t.Parallel()
+
+		svc, _ := newTestService(t)
+
+		firstIDs, inserted := importAll(t, svc, true)
+		if inserted != len(entries) {
+			t.Errorf("expected %v request logs to be inserted, got: %v", len(entries), inserted)
+		}
+
+		secondIDs, inserted := importAll(t, svc, true)
+		if inserted != 0 {
+			t.Errorf("expected no request logs to be inserted on re-import, got: %v", inserted)
+		}
+
+		if fmt.Sprint(firstIDs) != fmt.Sprint(secondIDs) {
+			t.Errorf("expected re-import to return existing request logs %v, got: %v", firstIDs, secondIDs)
+		}
+
+		if n := countRequestLogs(t, svc); n != len(entries) {
+			t.Errorf("expected %v request logs, got: %v", len(entries), n)
+		}
+
+		got, err := svc.FindRequestLogByID(context.Background(), firstIDs[1])
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got.Response == nil || string(got.Response.Body) != "foobar" {
+			t.Fatalf("expected response log to be imported, got: %+v", got.Response)
+		}
+
+		if !got.Response.Timestamp.Equal(entries[1].Response.Timestamp) {
+			t.Errorf("expected response timestamp %v, got: %v", entries[1].Response.Timestamp, got.Response.Timestamp)
+		}
+	})
+
+	t.Run("without idempotency keys", func(t *testing.T) {
+		t.Parallel()
+
+		svc, _ := newTestService(t)
+
+		importAll(t, svc, false)
+		importAll(t, svc, false)
+
+		if n := countRequestLogs(t, svc); n != 2*len(entries) {
+			t.Errorf("expected %v request logs, got: %v", 2*len(entries), n)
+		}
+	})
+	t.Run("bulk", func(t *testing.T) {
+		t.Parallel()
+
+		svc, _ := newTestService(t)
+
+		bulkEntries := make([]reqlog.ImportEntry, len(entries))
+		for i, entry := range entries {
+			bulkEntries[i] = reqlog.ImportEntry{Key: fmt.Sprintf("example.har#%v", i), Request: entry}
+		}
+
+		inserted, err := svc.BulkImportRequests(context.Background(), bulkEntries)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if inserted != len(entries) {
+			t.Errorf("expected %v request logs to be inserted, got: %v", len(entries), inserted)
+		}
+
+		// Imports with an invalid entry store nothing.
+		invalid := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+		invalid.URL.Host = ""
+
+		_, err = svc.BulkImportRequests(context.Background(), []reqlog.ImportEntry{
+			{Request: entries[0]},
+			{Request: reqlog.Request{Request: *invalid}},
+		})
+		if !errors.Is(err, reqlog.ErrInvalidRequest) {
+			t.Fatalf("expected error %v, got: %v", reqlog.ErrInvalidRequest, err)
+		}
+
+		if n := countRequestLogs(t, svc); n != len(entries) {
+			t.Errorf("expected %v request logs, got: %v", len(entries), n)
+		}
+
+		report, err := svc.CheckIntegrity(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !report.OK() {
+			t.Errorf("expected no orphans, got: %+v", report)
+		}
+	})
+}
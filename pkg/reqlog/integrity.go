@@ -0,0 +1,32 @@
+package reqlog
+
+import "context"
+
+// IntegrityReport is the number of stored records that don't belong to an
+// existing request log or response log.
+type IntegrityReport struct {
+	OrphanResponses int64
+	OrphanHeaders   int64
+}
+
+// OK reports whether no orphans were found.
+func (r IntegrityReport) OK() bool {
+	return r.OrphanResponses == 0 && r.OrphanHeaders == 0
+}
+
+// CheckIntegrity returns the number of orphaned response logs and headers.
+func (svc *Service) CheckIntegrity(ctx context.Context) (IntegrityReport, error) {
+	return svc.repo.CheckIntegrity(ctx)
+}
+
+// RepairIntegrity deletes orphaned response logs and headers, and returns the
+// number of deleted orphans.
+func (svc *Service) RepairIntegrity(ctx context.Context) (IntegrityReport, error) {
+	return svc.repo.DeleteOrphans(ctx)
+}
+
+// PruneOrphanHeaders deletes orphaned headers, and returns the number of
+// deleted headers.
+func (svc *Service) PruneOrphanHeaders(ctx context.Context) (int64, error) {
+	return svc.repo.PruneOrphanHeaders(ctx)
+}
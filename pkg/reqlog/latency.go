@@ -0,0 +1,51 @@
+package reqlog
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+)
+
+// LatencyStats contains percentiles of the durations between request and
+// response.
+type LatencyStats struct {
+	P50 time.Duration
+	P95 time.Duration
+	P99 time.Duration
+	Max time.Duration
+}
+
+// LatencyPercentiles returns latency stats of all request logs matching a
+// filter. The returned boolean is false if no matching request log has a
+// response.
+func (svc *Service) LatencyPercentiles(ctx context.Context, filter FindRequestsFilter) (LatencyStats, bool, error) {
+	durations, err := svc.repo.FindRequestLogDurations(ctx, filter, svc.scope)
+	if err != nil {
+		return LatencyStats{}, false, err
+	}
+
+	if len(durations) == 0 {
+		return LatencyStats{}, false, nil
+	}
+
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+	return LatencyStats{
+		P50: percentile(durations, 50),
+		P95: percentile(durations, 95),
+		P99: percentile(durations, 99),
+		Max: durations[len(durations)-1],
+	}, true, nil
+}
+
+// percentile returns the p-th percentile of sorted, non-empty durations, using
+// the nearest-rank method.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
@@ -0,0 +1,84 @@
+package reqlog_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+func TestLatencyPercentiles(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	addRequestLog := func(url string, duration time.Duration) {
+		t.Helper()
+
+		start := time.Now()
+
+		addTestRequestLog(t, db, reqlog.Request{
+			Request:   *httptest.NewRequest(http.MethodGet, url, nil),
+			Timestamp: start,
+			Response: &reqlog.Response{
+				Response:  http.Response{StatusCode: http.StatusOK, Proto: "HTTP/1.1"},
+				Timestamp: start.Add(duration),
+			},
+		})
+	}
+
+	// Durations of 1 to 100 ms for one host, and much slower requests for
+	// another host, which should be filtered out.
+	for i := 1; i <= 100; i++ {
+		addRequestLog("https://example.com/", time.Duration(i)*time.Millisecond)
+	}
+
+	for i := 0; i < 10; i++ {
+		addRequestLog("https://example.org/", time.Minute)
+	}
+
+	// A request without response shouldn't be taken into account.
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+	if _, err := db.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expr, err := search.ParseQuery(`req.url =~ "example.com"`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	stats, ok, err := svc.LatencyPercentiles(ctx, reqlog.FindRequestsFilter{SearchExpr: expr})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !ok {
+		t.Fatal("expected latency stats")
+	}
+
+	exp := reqlog.LatencyStats{
+		P50: 50 * time.Millisecond,
+		P95: 95 * time.Millisecond,
+		P99: 99 * time.Millisecond,
+		Max: 100 * time.Millisecond,
+	}
+
+	if stats != exp {
+		t.Errorf("expected latency stats %+v, got: %+v", exp, stats)
+	}
+
+	stats, _, err = svc.LatencyPercentiles(ctx, reqlog.FindRequestsFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if stats.Max != time.Minute {
+		t.Errorf("expected max latency %v without filter, got: %v", time.Minute, stats.Max)
+	}
+}
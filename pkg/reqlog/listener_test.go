@@ -0,0 +1,88 @@
+package reqlog_test
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestListener(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	plainTarget := httptest.NewServer(handler)
+	defer plainTarget.Close()
+
+	tlsTarget := httptest.NewTLSServer(handler)
+	defer tlsTarget.Close()
+
+	// Two proxy listeners, e.g. one per client, share a proxy.
+	p := newTestProxy(t, svc)
+
+	var listeners []string
+
+	for i := 0; i < 2; i++ {
+		proxySrv := httptest.NewUnstartedServer(p)
+		proxySrv.Config.ConnContext = p.ConnContext
+		proxySrv.Start()
+
+		defer proxySrv.Close()
+
+		proxyURL, err := url.Parse(proxySrv.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// The target is an IP address, so the client must indicate a server
+		// name for the proxy to create a certificate for.
+		client := &http.Client{Transport: &http.Transport{
+			Proxy: http.ProxyURL(proxyURL),
+			TLSClientConfig: &tls.Config{
+				ServerName:         "example.com",
+				InsecureSkipVerify: true, // nolint:gosec
+			},
+		}}
+
+		// Requests received via a CONNECT tunnel have the listener of the
+		// CONNECT request. Requests are logged before they're forwarded, so
+		// the response of the TLS target is irrelevant.
+		for _, u := range []string{plainTarget.URL, tlsTarget.URL} {
+			res, err := client.Get(u)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			res.Body.Close()
+		}
+
+		listeners = append(listeners, proxySrv.Listener.Addr().String())
+	}
+
+	for _, listener := range listeners {
+		reqLogs, err := db.FindRequestLogs(ctx, reqlog.FindRequestsFilter{Listener: listener}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(reqLogs) != 2 {
+			t.Fatalf("expected 2 request logs for listener %v, got: %v", listener, len(reqLogs))
+		}
+
+		for _, reqLog := range reqLogs {
+			if reqLog.Listener != listener {
+				t.Errorf("expected listener %q for request to %v, got: %q", listener, reqLog.Request.URL, reqLog.Listener)
+			}
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package reqlog
+
+import (
+	"bytes"
+	"regexp"
+	"sort"
+
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+// MatchRange is the position of a search match in a body, in bytes.
+type MatchRange struct {
+	Start  int
+	Length int
+}
+
+// BodyMatches returns the ranges in body that match a search expression, for
+// highlighting search results. Field is the string literal the body is
+// searched by (i.e. "req.body" or "res.body"). The ranges mirror how the
+// database matches: bare terms by each (non-overlapping) occurrence, regular
+// expressions (`=~`) by each match, and equality (`=`) by the whole body.
+// Negated comparisons have no ranges. At most limit ranges are returned,
+// sorted by start.
+func BodyMatches(expr search.Expression, field string, body []byte, caseInsensitive bool, limit int) []MatchRange {
+	ranges := bodyMatches(expr, field, body, caseInsensitive)
+
+	sort.Slice(ranges, func(i, j int) bool {
+		if ranges[i].Start != ranges[j].Start {
+			return ranges[i].Start < ranges[j].Start
+		}
+
+		return ranges[i].Length < ranges[j].Length
+	})
+
+	// Drop duplicates, e.g. of terms that occur in both operands of `OR`.
+	deduped := ranges[:0]
+	for i, r := range ranges {
+		if i > 0 && r == ranges[i-1] {
+			continue
+		}
+
+		deduped = append(deduped, r)
+	}
+
+	if len(deduped) > limit {
+		deduped = deduped[:limit]
+	}
+
+	return deduped
+}
+
+func bodyMatches(expr search.Expression, field string, body []byte, caseInsensitive bool) []MatchRange {
+	switch e := expr.(type) {
+	case *search.StringLiteral:
+		return termMatches(body, []byte(e.Value), caseInsensitive)
+	case *search.InfixExpression:
+		switch e.Operator {
+		case search.TokOpAnd, search.TokOpOr:
+			return append(
+				bodyMatches(e.Left, field, body, caseInsensitive),
+				bodyMatches(e.Right, field, body, caseInsensitive)...,
+			)
+		}
+
+		left, ok := e.Left.(*search.StringLiteral)
+		if !ok || left.Value != field {
+			return nil
+		}
+
+		right, ok := e.Right.(*search.StringLiteral)
+		if !ok {
+			return nil
+		}
+
+		switch e.Operator {
+		case search.TokOpEq:
+			if len(body) > 0 && equalBody(body, []byte(right.Value), caseInsensitive) {
+				return []MatchRange{{Start: 0, Length: len(body)}}
+			}
+		case search.TokOpRe:
+			return regexpMatches(body, right.Value, caseInsensitive)
+		}
+	}
+
+	return nil
+}
+
+func termMatches(body, term []byte, caseInsensitive bool) []MatchRange {
+	if len(term) == 0 {
+		return nil
+	}
+
+	// Only ASCII characters are folded, like the database does. This keeps
+	// offsets in the lowercased body valid for the original body.
+	if caseInsensitive {
+		body = asciiLower(body)
+		term = asciiLower(term)
+	}
+
+	var ranges []MatchRange
+
+	for offset := 0; ; {
+		i := bytes.Index(body[offset:], term)
+		if i == -1 {
+			break
+		}
+
+		ranges = append(ranges, MatchRange{Start: offset + i, Length: len(term)})
+		offset += i + len(term)
+	}
+
+	return ranges
+}
+
+func regexpMatches(body []byte, pattern string, caseInsensitive bool) []MatchRange {
+	if caseInsensitive {
+		pattern = "(?i)" + pattern
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil
+	}
+
+	var ranges []MatchRange
+
+	for _, loc := range re.FindAllIndex(body, -1) {
+		// Empty matches can't be highlighted.
+		if loc[1] == loc[0] {
+			continue
+		}
+
+		ranges = append(ranges, MatchRange{Start: loc[0], Length: loc[1] - loc[0]})
+	}
+
+	return ranges
+}
+
+func equalBody(body, value []byte, caseInsensitive bool) bool {
+	if caseInsensitive {
+		return bytes.Equal(asciiLower(body), asciiLower(value))
+	}
+
+	return bytes.Equal(body, value)
+}
+
+func asciiLower(b []byte) []byte {
+	lower := make([]byte, len(b))
+
+	for i, c := range b {
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+
+		lower[i] = c
+	}
+
+	return lower
+}
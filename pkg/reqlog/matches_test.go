@@ -0,0 +1,103 @@
+package reqlog_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+func TestBodyMatches(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		query           string
+		field           string
+		body            string
+		caseInsensitive bool
+		limit           int
+		exp             []reqlog.MatchRange
+	}{
+		{
+			name:  "multiple occurrences of a term",
+			query: "foo",
+			field: "req.body",
+			body:  "foo bar foo foofoo",
+			limit: 100,
+			exp:   []reqlog.MatchRange{{Start: 0, Length: 3}, {Start: 8, Length: 3}, {Start: 12, Length: 3}, {Start: 15, Length: 3}},
+		},
+		{
+			name:            "case-insensitive term",
+			query:           "foo",
+			field:           "req.body",
+			body:            "Foo FOO",
+			caseInsensitive: true,
+			limit:           100,
+			exp:             []reqlog.MatchRange{{Start: 0, Length: 3}, {Start: 4, Length: 3}},
+		},
+		{
+			name:  "regular expression on field",
+			query: `res.body =~ "b[a-z]+"`,
+			field: "res.body",
+			body:  "foo bar baz",
+			limit: 100,
+			exp:   []reqlog.MatchRange{{Start: 4, Length: 3}, {Start: 8, Length: 3}},
+		},
+		{
+			name:  "regular expression on other field",
+			query: `req.body =~ "b[a-z]+"`,
+			field: "res.body",
+			body:  "foo bar baz",
+			limit: 100,
+			exp:   []reqlog.MatchRange{},
+		},
+		{
+			name:  "equality",
+			query: `req.body = "foo"`,
+			field: "req.body",
+			body:  "foo",
+			limit: 100,
+			exp:   []reqlog.MatchRange{{Start: 0, Length: 3}},
+		},
+		{
+			name:  "terms combined with OR, sorted and deduplicated",
+			query: "bar OR foo OR bar",
+			field: "req.body",
+			body:  "foo bar",
+			limit: 100,
+			exp:   []reqlog.MatchRange{{Start: 0, Length: 3}, {Start: 4, Length: 3}},
+		},
+		{
+			name:  "capped",
+			query: "a",
+			field: "req.body",
+			body:  "aaaa",
+			limit: 2,
+			exp:   []reqlog.MatchRange{{Start: 0, Length: 1}, {Start: 1, Length: 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := search.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := reqlog.BodyMatches(expr, tt.field, []byte(tt.body), tt.caseInsensitive, tt.limit)
+
+			if len(got) == 0 && len(tt.exp) == 0 {
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf("expected matches %v, got: %v", tt.exp, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package reqlog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidMetadata = errors.New("reqlog: invalid metadata")
+
+// SetMetadata annotates a request log with arbitrary data, e.g. results of
+// external tools. Keys should be namespaced (e.g. "scanner.cvss") to prevent
+// collisions between integrations. Values must be valid JSON. Setting a key
+// that already exists overwrites its value.
+func (svc *Service) SetMetadata(ctx context.Context, reqID int64, key string, value json.RawMessage) error {
+	if strings.TrimSpace(key) == "" {
+		return fmt.Errorf("%w: key cannot be empty", ErrInvalidMetadata)
+	}
+
+	if !json.Valid(value) {
+		return fmt.Errorf("%w: value must be valid JSON", ErrInvalidMetadata)
+	}
+
+	return svc.repo.SetMetadata(ctx, reqID, key, value)
+}
+
+// GetMetadata returns all metadata of a request log, by key.
+func (svc *Service) GetMetadata(ctx context.Context, reqID int64) (map[string]json.RawMessage, error) {
+	return svc.repo.GetMetadata(ctx, reqID)
+}
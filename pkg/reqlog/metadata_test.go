@@ -0,0 +1,77 @@
+package reqlog_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestMetadata(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+	reqLog, err := db.AddRequestLog(ctx, reqlog.Request{Request: *req, Timestamp: time.Now()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := svc.SetMetadata(ctx, reqLog.ID, "scanner.cvss", json.RawMessage(`5.3`)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := svc.SetMetadata(ctx, reqLog.ID, "scanner.cvss", json.RawMessage(`{"score":9.8}`)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	metadata, err := svc.GetMetadata(ctx, reqLog.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(metadata) != 1 {
+		t.Fatalf("expected 1 metadata key, got: %v", len(metadata))
+	}
+
+	if exp, got := `{"score":9.8}`, string(metadata["scanner.cvss"]); got != exp {
+		t.Errorf("expected overwritten value %v, got: %v", exp, got)
+	}
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		err := svc.SetMetadata(ctx, reqLog.ID, "scanner.cvss", json.RawMessage(`{`))
+		if !errors.Is(err, reqlog.ErrInvalidMetadata) {
+			t.Errorf("expected error %v, got: %v", reqlog.ErrInvalidMetadata, err)
+		}
+	})
+
+	t.Run("unknown request log", func(t *testing.T) {
+		err := svc.SetMetadata(ctx, reqLog.ID+1, "scanner.cvss", json.RawMessage(`1`))
+		if !errors.Is(err, reqlog.ErrRequestNotFound) {
+			t.Errorf("expected error %v, got: %v", reqlog.ErrRequestNotFound, err)
+		}
+	})
+
+	t.Run("deleted with request log", func(t *testing.T) {
+		if err := svc.ClearRequests(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		metadata, err := svc.GetMetadata(ctx, reqLog.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(metadata) != 0 {
+			t.Errorf("expected metadata to be deleted, got: %v", metadata)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package reqlog
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+)
+
+// RawRequest reconstructs the wire format of a logged request, with the request
+// line in origin form (e.g. "GET /foo HTTP/1.1"), followed by the `Host` header,
+// the other headers (sorted by key) and the body. It isn't necessarily identical
+// to the request as sent, e.g. because header order and casing aren't stored.
+func RawRequest(reqLog Request) []byte {
+	buf := &bytes.Buffer{}
+
+	requestURI := "/"
+	if reqLog.Request.URL != nil {
+		requestURI = reqLog.Request.URL.RequestURI()
+	}
+
+	fmt.Fprintf(buf, "%v %v %v\r\n", reqLog.Request.Method, requestURI, rawProto(reqLog.Request.Proto))
+
+	host := reqLog.Request.Host
+	if host == "" && reqLog.Request.URL != nil {
+		host = reqLog.Request.URL.Host
+	}
+
+	if host != "" {
+		fmt.Fprintf(buf, "Host: %v\r\n", host)
+	}
+
+	_ = reqLog.Request.Header.WriteSubset(buf, map[string]bool{"Host": true})
+
+	buf.WriteString("\r\n")
+	buf.Write(reqLog.Body)
+
+	return buf.Bytes()
+}
+
+// RawResponse reconstructs the wire format of a logged response: the status
+// line, headers (sorted by key) and body. Gzip encoded bodies are stored
+// decoded, so the body of these responses doesn't match their
+// `Content-Encoding` header.
+func RawResponse(resLog Response) []byte {
+	buf := &bytes.Buffer{}
+
+	status := resLog.Response.Status
+	if status == "" {
+		status = fmt.Sprintf("%d %v", resLog.Response.StatusCode, http.StatusText(resLog.Response.StatusCode))
+	}
+
+	fmt.Fprintf(buf, "%v %v\r\n", rawProto(resLog.Response.Proto), status)
+
+	_ = resLog.Response.Header.Write(buf)
+
+	buf.WriteString("\r\n")
+	buf.Write(resLog.Body)
+
+	return buf.Bytes()
+}
+
+func rawProto(proto string) string {
+	if proto == "" {
+		return "HTTP/1.1"
+	}
+
+	return proto
+}
@@ -0,0 +1,15 @@
+package reqlog
+
+import "context"
+
+// MarkAllRead marks all request logs as read. Request logs that are added
+// afterwards are unread. It returns the number of request logs that were
+// unread.
+func (svc *Service) MarkAllRead(ctx context.Context) (int64, error) {
+	return svc.repo.MarkAllRequestLogsRead(ctx)
+}
+
+// UnreadCount returns the number of unread request logs.
+func (svc *Service) UnreadCount(ctx context.Context) (int64, error) {
+	return svc.repo.CountUnreadRequestLogs(ctx)
+}
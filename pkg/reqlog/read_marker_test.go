@@ -0,0 +1,137 @@
+package reqlog_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestMarkAllRead(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+	assertUnread := func(t *testing.T, exp []int64) {
+		t.Helper()
+
+		n, err := svc.UnreadCount(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if n != int64(len(exp)) {
+			t.Errorf("expected unread count %v, got: %v", len(exp), n)
+		}
+
+		unread := true
+
+		reqLogs, err := db.FindRequestLogs(ctx, reqlog.FindRequestsFilter{Unread: &unread}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got := []int64{}
+
+		for _, reqLog := range reqLogs {
+			if !reqLog.Unread {
+				t.Errorf("expected request log %v to be flagged as unread", reqLog.ID)
+			}
+
+			got = append(got, reqLog.ID)
+		}
+
+		if !reflect.DeepEqual(exp, got) {
+			t.Errorf("expected unread request logs %v, got: %v", exp, got)
+		}
+	}
+
+	first := addTestRequestLog(t, db, reqlog.Request{Request: *req}).ID
+	second := addTestRequestLog(t, db, reqlog.Request{Request: *req}).ID
+
+	assertUnread(t, []int64{second, first})
+
+	n, err := svc.MarkAllRead(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n != 2 {
+		t.Errorf("expected 2 request logs to be marked as read, got: %v", n)
+	}
+
+	assertUnread(t, []int64{})
+
+	third := addTestRequestLog(t, db, reqlog.Request{Request: *req}).ID
+
+	assertUnread(t, []int64{third})
+
+	reqLog, err := db.FindRequestLogByID(ctx, first)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if reqLog.Unread {
+		t.Errorf("expected request log %v to be read", first)
+	}
+}
+
+func TestMarkAllReadDeletedRequestLogs(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+	assertUnreadCount := func(t *testing.T, exp int64) {
+		t.Helper()
+
+		n, err := svc.UnreadCount(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if n != exp {
+			t.Errorf("expected unread count %v, got: %v", exp, n)
+		}
+	}
+
+	addTestRequestLog(t, db, reqlog.Request{Request: *req})
+	newest := addTestRequestLog(t, db, reqlog.Request{Request: *req}).ID
+
+	if _, err := svc.MarkAllRead(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The newest request log is a duplicate, so it's deleted.
+	if _, err := svc.DeleteDuplicates(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	added := addTestRequestLog(t, db, reqlog.Request{Request: *req}).ID
+
+	if added == newest {
+		t.Errorf("expected ID %v of deleted request log not to be reused", newest)
+	}
+
+	assertUnreadCount(t, 1)
+
+	if _, err := svc.MarkAllRead(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := svc.ClearRequests(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	addTestRequestLog(t, db, reqlog.Request{Request: *req})
+
+	assertUnreadCount(t, 1)
+}
@@ -0,0 +1,22 @@
+package reqlog
+
+import "context"
+
+const (
+	defaultRecentRequestsLimit = 20
+	maxRecentRequestsLimit     = 100
+)
+
+// RecentRequests returns the most recent request logs, newest first. Only the
+// ID, method, URL and response status are set, and the project filter isn't
+// applied. The limit defaults to 20 if it's not positive, and is capped at 100.
+func (svc *Service) RecentRequests(ctx context.Context, limit int) ([]Request, error) {
+	switch {
+	case limit <= 0:
+		limit = defaultRecentRequestsLimit
+	case limit > maxRecentRequestsLimit:
+		limit = maxRecentRequestsLimit
+	}
+
+	return svc.repo.FindRecentRequestLogs(ctx, limit)
+}
@@ -0,0 +1,20 @@
+package reqlog
+
+import "context"
+
+// FindRedirectChain returns the request logs of the redirect chain that a
+// request log is part of, from the first request to the last redirect. It
+// returns no request logs if the request log wasn't redirected, and wasn't
+// redirected from another request log.
+func (svc *Service) FindRedirectChain(ctx context.Context, id int64) ([]Request, error) {
+	reqLogs, err := svc.repo.FindRedirectChain(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(reqLogs) < 2 {
+		return nil, nil
+	}
+
+	return reqLogs, nil
+}
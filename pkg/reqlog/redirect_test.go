@@ -0,0 +1,109 @@
+package reqlog_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestResendRequestFollowRedirects(t *testing.T) {
+	t.Parallel()
+
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/step", http.StatusFound)
+	})
+	mux.HandleFunc("/step", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/done", http.StatusTemporaryRedirect)
+	})
+	mux.HandleFunc("/done", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("X-Method", r.Method)
+		w.Write(body)
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL + "/login")
+
+	created, err := svc.CreateRequest(ctx, http.Request{
+		Method: http.MethodPost,
+		URL:    u,
+		Header: http.Header{"Content-Type": []string{"text/plain"}},
+	}, []byte("foobar"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	last, err := svc.ResendRequest(ctx, created.ID, reqlog.ResendOptions{FollowRedirects: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	chain, err := svc.FindRedirectChain(ctx, last.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(chain) != 3 {
+		t.Fatalf("expected 3 request logs in redirect chain, got: %v", len(chain))
+	}
+
+	if chain[2].ID != last.ID {
+		t.Errorf("expected last hop %v to be returned, got: %v", chain[2].ID, last.ID)
+	}
+
+	var paths []string
+	for _, reqLog := range chain {
+		paths = append(paths, reqLog.Request.URL.Path)
+	}
+
+	if exp := []string{"/login", "/step", "/done"}; !reflect.DeepEqual(exp, paths) {
+		t.Errorf("expected redirect chain %v, got: %v", exp, paths)
+	}
+
+	if chain[0].RedirectedFrom != 0 || chain[1].RedirectedFrom != chain[0].ID || chain[2].RedirectedFrom != chain[1].ID {
+		t.Errorf("expected hops to be linked, got redirected from IDs: %v, %v, %v",
+			chain[0].RedirectedFrom, chain[1].RedirectedFrom, chain[2].RedirectedFrom)
+	}
+
+	// A 302 changes the method to GET and drops the body, and a 307 keeps
+	// both.
+	if got := chain[2].Response.Response.Header.Get("X-Method"); got != http.MethodGet {
+		t.Errorf("expected last hop to be sent with method %v, got: %v", http.MethodGet, got)
+	}
+
+	if len(chain[2].Body) != 0 {
+		t.Errorf("expected last hop without body, got: %q", chain[2].Body)
+	}
+
+	// The chain is the same for every request log in it.
+	first, err := svc.FindRedirectChain(ctx, chain[0].ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(first) != 3 {
+		t.Errorf("expected 3 request logs in redirect chain of first request, got: %v", len(first))
+	}
+
+	// The original request wasn't redirected, so it has no chain.
+	orig, err := svc.FindRedirectChain(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(orig) != 0 {
+		t.Errorf("expected no redirect chain for request that wasn't redirected, got: %v", len(orig))
+	}
+}
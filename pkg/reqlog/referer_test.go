@@ -0,0 +1,59 @@
+package reqlog_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestFindParentRequests(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	addRequestLog := func(url, referer string) int64 {
+		t.Helper()
+
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		if referer != "" {
+			req.Header.Set("Referer", referer)
+		}
+
+		return addTestRequestLog(t, db, reqlog.Request{Request: *req}).ID
+	}
+
+	parentID := addRequestLog("https://example.com/", "")
+	addRequestLog("https://example.com/other", "")
+	childID := addRequestLog("https://example.com/app.js", "https://example.com/")
+
+	parents, err := svc.FindParentRequests(ctx, childID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(parents) != 1 || parents[0].ID != parentID {
+		t.Fatalf("expected parent request log %v, got: %+v", parentID, parents)
+	}
+
+	child, err := svc.FindRequestLogByID(ctx, childID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if exp := "https://example.com/"; child.Referer != exp {
+		t.Errorf("expected referer %q, got: %q", exp, child.Referer)
+	}
+
+	parents, err = svc.FindParentRequests(ctx, parentID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(parents) != 0 {
+		t.Errorf("expected no parent request logs for request without referer, got: %+v", parents)
+	}
+}
@@ -0,0 +1,116 @@
+package reqlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Limits of a replay, so that a replay can't be used to flood a host.
+const (
+	maxReplayCount       = 1000
+	maxReplayConcurrency = 50
+)
+
+var ErrInvalidReplay = errors.New("reqlog: invalid replay")
+
+// ReplaySummary is the result of replaying a request log. Latencies are the
+// durations from sending a request until its response body was read, of the
+// requests that succeeded.
+type ReplaySummary struct {
+	Count      int
+	Succeeded  int
+	Failed     int
+	MinLatency time.Duration
+	MaxLatency time.Duration
+	AvgLatency time.Duration
+}
+
+// ReplayRequest sends a stored request count times, with up to concurrency
+// requests in flight, for a simple load test. Each request is stored with its
+// response as a new request log, like with ResendRequest. Requests that can't
+// be sent or stored are counted as failed. If ctx is done, no more requests are
+// sent, and requests in flight are cancelled.
+func (svc *Service) ReplayRequest(ctx context.Context, id int64, count, concurrency int) (ReplaySummary, error) {
+	if count < 1 || count > maxReplayCount {
+		return ReplaySummary{}, fmt.Errorf("%w: count must be between 1 and %v", ErrInvalidReplay, maxReplayCount)
+	}
+
+	if concurrency < 1 || concurrency > maxReplayConcurrency {
+		return ReplaySummary{}, fmt.Errorf("%w: concurrency must be between 1 and %v",
+			ErrInvalidReplay, maxReplayConcurrency)
+	}
+
+	if concurrency > count {
+		concurrency = count
+	}
+
+	orig, err := svc.repo.FindRequestLogByID(WithAllFields(ctx), id)
+	if err != nil {
+		return ReplaySummary{}, err
+	}
+
+	var (
+		mu           sync.Mutex
+		wg           sync.WaitGroup
+		summary      = ReplaySummary{Count: count}
+		totalLatency time.Duration
+	)
+
+	jobs := make(chan struct{})
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range jobs {
+				_, latency, err := svc.resend(ctx, orig, ResendOptions{}, 0)
+
+				mu.Lock()
+
+				if err != nil {
+					summary.Failed++
+				} else {
+					if summary.Succeeded == 0 || latency < summary.MinLatency {
+						summary.MinLatency = latency
+					}
+
+					if latency > summary.MaxLatency {
+						summary.MaxLatency = latency
+					}
+
+					summary.Succeeded++
+					totalLatency += latency
+				}
+
+				mu.Unlock()
+			}
+		}()
+	}
+
+send:
+	for i := 0; i < count; i++ {
+		select {
+		case jobs <- struct{}{}:
+		case <-ctx.Done():
+			break send
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return summary, fmt.Errorf("reqlog: replay was aborted: %w", err)
+	}
+
+	if summary.Succeeded > 0 {
+		summary.AvgLatency = totalLatency / time.Duration(summary.Succeeded)
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,120 @@
+package reqlog_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestReplayRequest(t *testing.T) {
+	t.Parallel()
+
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	var hits int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL + "/foo")
+
+	created, err := svc.CreateRequest(ctx, http.Request{Method: http.MethodGet, URL: u}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	summary, err := svc.ReplayRequest(ctx, created.ID, 5, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if summary.Count != 5 || summary.Succeeded != 5 || summary.Failed != 0 {
+		t.Errorf("expected 5 succeeded requests, got: %+v", summary)
+	}
+
+	if summary.MinLatency <= 0 || summary.MinLatency > summary.AvgLatency || summary.AvgLatency > summary.MaxLatency {
+		t.Errorf("expected min <= avg <= max latency, got: %+v", summary)
+	}
+
+	if n := atomic.LoadInt32(&hits); n != 5 {
+		t.Errorf("expected 5 requests to be sent, got: %v", n)
+	}
+
+	reqLogs, err := svc.FindRequests(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The created request log, and the replayed ones.
+	if len(reqLogs) != 6 {
+		t.Fatalf("expected 6 request logs, got: %v", len(reqLogs))
+	}
+
+	for _, reqLog := range reqLogs {
+		if reqLog.ID == created.ID {
+			continue
+		}
+
+		if reqLog.Response == nil || reqLog.Response.Response.StatusCode != http.StatusNoContent {
+			t.Errorf("expected replayed request log %v to have its response log", reqLog.ID)
+		}
+	}
+
+	if _, err := svc.ReplayRequest(ctx, created.ID, 0, 1); !errors.Is(err, reqlog.ErrInvalidReplay) {
+		t.Errorf("expected error %v, got: %v", reqlog.ErrInvalidReplay, err)
+	}
+
+	if _, err := svc.ReplayRequest(ctx, 42, 1, 1); !errors.Is(err, reqlog.ErrRequestNotFound) {
+		t.Errorf("expected error %v, got: %v", reqlog.ErrRequestNotFound, err)
+	}
+}
+
+func TestReplayRequestCancel(t *testing.T) {
+	t.Parallel()
+
+	svc, _ := newTestService(t)
+
+	var hits int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL + "/slow")
+
+	created, err := svc.CreateRequest(context.Background(), http.Request{Method: http.MethodGet, URL: u}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	_, err = svc.ReplayRequest(ctx, created.ID, 100, 2)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected error %v, got: %v", context.DeadlineExceeded, err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected replay to be aborted, took: %v", elapsed)
+	}
+
+	if n := atomic.LoadInt32(&hits); n > 2 {
+		t.Errorf("expected at most 2 requests to be sent, got: %v", n)
+	}
+}
@@ -2,7 +2,7 @@ package reqlog
 
 import (
 	"context"
-	"net/http"
+	"encoding/json"
 	"time"
 
 	"github.com/dstotijn/hetty/pkg/scope"
@@ -14,10 +14,63 @@ type RepositoryProvider interface {
 
 type Repository interface {
 	FindRequestLogs(ctx context.Context, filter FindRequestsFilter, scope *scope.Scope) ([]Request, error)
+	StreamRequestLogs(ctx context.Context, filter FindRequestsFilter, scope *scope.Scope, fn func(Request) error) error
+	CountRequestLogs(ctx context.Context, filter FindRequestsFilter, scope *scope.Scope) (int64, error)
 	FindRequestLogByID(ctx context.Context, id int64) (Request, error)
-	AddRequestLog(ctx context.Context, req http.Request, body []byte, timestamp time.Time) (*Request, error)
-	AddResponseLog(ctx context.Context, reqID int64, res http.Response, body []byte, timestamp time.Time) (*Response, error) // nolint:lll
+	FindRequestLogsByIDs(ctx context.Context, ids []int64) ([]Request, error)
+	FindParentRequestLogs(ctx context.Context, reqID int64) ([]Request, error)
+	FindRedirectChain(ctx context.Context, reqID int64) ([]Request, error)
+	FindRequestLogsBySession(ctx context.Context, cookieName, value string) ([]Request, error)
+	FindRequestLogsByBodyHash(ctx context.Context, hash string) ([]Request, error)
+	FindRecentRequestLogs(ctx context.Context, limit int) ([]Request, error)
+	SetRequestLogBaseline(ctx context.Context, reqID int64, baseline bool) error
+	SetRequestLogStarred(ctx context.Context, reqID int64, starred bool) error
+	FindResponseBody(ctx context.Context, reqID int64) (ResponseBody, error)
+	AddRequestLog(ctx context.Context, reqLog Request) (*Request, error)
+	// AddResponseLog stores reqLog.Response as the response log of the request
+	// log with reqLog.ID.
+	AddResponseLog(ctx context.Context, reqLog Request) (*Response, error)
+	// AddRequestResponse stores a request log and its response log
+	// (reqLog.Response) atomically.
+	AddRequestResponse(ctx context.Context, reqLog Request) (*Request, error)
+	// ImportRequestLog stores a request log and its response log (if any). If
+	// key isn't empty and was used before, nothing is stored, and the existing
+	// request log is returned. It reports whether the request log was inserted.
+	ImportRequestLog(ctx context.Context, key string, reqLog Request) (*Request, bool, error)
+	// BulkImportRequestLogs stores request logs and their response logs (if
+	// any) in a single transaction, optimized for large imports. Entries with
+	// a key that was used before are skipped. It returns the number of
+	// inserted request logs.
+	BulkImportRequestLogs(ctx context.Context, entries []ImportEntry) (int, error)
 	ClearRequestLogs(ctx context.Context) error
+	// WithTx calls fn with a repository that runs all operations in a single
+	// transaction, which is committed if fn returns nil, and rolled back
+	// otherwise.
+	WithTx(ctx context.Context, fn func(Repository) error) error
+	TagRequestLogs(ctx context.Context, filter FindRequestsFilter, scope *scope.Scope, name string, color *string) (int64, error) // nolint:lll
+	UntagRequestLogs(ctx context.Context, filter FindRequestsFilter, scope *scope.Scope, name string) (int64, error)
+	FindRequestLogTags(ctx context.Context, reqID int64) ([]Tag, error)
+	FindRequestLogDurations(ctx context.Context, filter FindRequestsFilter, scope *scope.Scope) ([]time.Duration, error)
+	FindTopHosts(ctx context.Context, filter FindRequestsFilter, scope *scope.Scope, limit int) ([]HostCount, error)
+	FindTopBodyHashes(ctx context.Context, limit int) ([]BodyHashCount, error)
+	FindDistinctStatusCodes(ctx context.Context, filter FindRequestsFilter, scope *scope.Scope) ([]int, error)
+	SetMetadata(ctx context.Context, reqID int64, key string, value json.RawMessage) error
+	GetMetadata(ctx context.Context, reqID int64) (map[string]json.RawMessage, error)
+	FindDuplicateRequestLogs(ctx context.Context) ([]DuplicateRequests, error)
+	CountDuplicateRequestLogs(ctx context.Context) (int64, error)
+	DeleteDuplicateRequestLogs(ctx context.Context) (int64, error)
+	// CheckIntegrity returns the number of response logs and headers that don't
+	// belong to an existing request log or response log.
+	CheckIntegrity(ctx context.Context) (IntegrityReport, error)
+	DeleteOrphans(ctx context.Context) (IntegrityReport, error)
+	PruneOrphanHeaders(ctx context.Context) (int64, error)
+	MarkAllRequestLogsRead(ctx context.Context) (int64, error)
+	CountUnreadRequestLogs(ctx context.Context) (int64, error)
+	FindLogsVersion(ctx context.Context) (LogsVersion, error)
+	SaveSearch(ctx context.Context, name string, filter FindRequestsFilter) (SavedSearch, error)
+	FindSavedSearches(ctx context.Context) ([]SavedSearch, error)
+	FindSavedSearchByID(ctx context.Context, id int64) (SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, id int64) error
 	UpsertSettings(ctx context.Context, module string, settings interface{}) error
 	FindSettingsByModule(ctx context.Context, module string, settings interface{}) error
 }
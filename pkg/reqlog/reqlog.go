@@ -7,9 +7,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/dstotijn/hetty/pkg/proj"
@@ -20,18 +22,90 @@ import (
 
 type contextKey int
 
-const LogBypassedKey contextKey = 0
+const (
+	LogBypassedKey contextKey = 0
+	asyncLogKey    contextKey = 1
+)
 
 const moduleName = "reqlog"
 
-var ErrRequestNotFound = errors.New("reqlog: request not found")
+const defaultMaxBodySize = 10 << 20
+
+var (
+	ErrRequestNotFound = errors.New("reqlog: request not found")
+	ErrShutdown        = errors.New("reqlog: service is shut down")
+)
 
 type Request struct {
-	ID        int64
-	Request   http.Request
-	Body      []byte
+	ID      int64
+	Request http.Request
+	Body    []byte
+	// Timestamp is always in UTC, regardless of the location of the time
+	// passed when the request log was added.
 	Timestamp time.Time
 	Response  *Response
+	// Fingerprint is a hash of the method, URL and body, used for detecting
+	// duplicate requests.
+	Fingerprint string
+	// Referer is the value of the `Referer` header, which links a request log
+	// to the request log of the page it was requested from.
+	Referer string
+	// HeadersTruncated is true if headers were dropped or truncated when they
+	// were stored, because they exceeded the configured limits.
+	HeadersTruncated bool
+	// ServerAddr is the remote address of the connection the request was sent
+	// on, if known. When an upstream proxy is used, it's the address of the
+	// upstream proxy.
+	ServerAddr string
+	// ClientCert is true if the request was sent with a client certificate that
+	// was configured for its host, for mutual TLS.
+	ClientCert bool
+	// BodyTruncated is true if only the first part of the body was stored,
+	// because it exceeded the configured maximum body size.
+	BodyTruncated bool
+	// BodySize is the size (in bytes) of the stored body. It's set when a
+	// request log is read from the repository, even if the body itself isn't.
+	BodySize int64
+	// BodyLineCount is the number of lines of the stored body, if it's text,
+	// and nil for binary bodies. Because it's computed from the body, it's only
+	// set when it's selected in a GraphQL query.
+	BodyLineCount *int64
+	// CanonicalURL is the normalized URL, if the repository is configured to
+	// normalize URLs. It's used instead of the URL for the fingerprint.
+	CanonicalURL string
+	// IsBaseline is true if the request log is marked as a baseline, which
+	// other request logs can be compared against.
+	IsBaseline bool
+	// Starred is true if the request log is starred, to bookmark it.
+	Starred bool
+	// ConnID is the ID the proxy assigned to the client connection the request
+	// was received on, or 0 if it's unknown. Requests sent on the same
+	// (keep-alive) connection share the ID.
+	ConnID int64
+	// Listener is the address of the proxy listener (e.g. ":8080") the client
+	// connection of the request was accepted on, or empty if it's unknown.
+	Listener string
+	// RedirectedFrom is the ID of the request log that this request log was
+	// redirected from, when a resent request followed redirects, or 0.
+	RedirectedFrom int64
+	// GRPCMethod is the full gRPC method (e.g. "helloworld.Greeter/SayHello")
+	// if it's a gRPC request. The bodies of gRPC requests and their responses
+	// are length-prefixed protobuf messages, rather than text.
+	GRPCMethod string
+	// SNI is the server name the client indicated in the TLS handshake with
+	// the proxy, i.e. the host it requested a certificate for. It's empty for
+	// plaintext requests. An SNI that differs from the `Host` header is a sign
+	// of domain fronting.
+	SNI string
+	// BodyHash is the hex encoded SHA-256 hash of the stored body, or empty if
+	// the body is empty.
+	BodyHash string
+	// HeadersErr is set if the stored headers couldn't be read, e.g. because
+	// of corrupt data. The headers are empty then.
+	HeadersErr error `json:"-"`
+	// Unread is true if the request log was added after the request logs were
+	// last marked as read.
+	Unread bool
 }
 
 type Response struct {
@@ -39,21 +113,156 @@ type Response struct {
 	RequestID int64
 	Response  http.Response
 	Body      []byte
+	// Timestamp is always in UTC.
 	Timestamp time.Time
+	// ContentLengthMismatch is true if the length of the body differs from
+	// the `Content-Length` header.
+	ContentLengthMismatch bool
+	// BodySkipped is true if the body wasn't stored, because of its content
+	// type, or because response bodies weren't captured.
+	BodySkipped bool
+	// HeadersTruncated is true if headers were dropped or truncated when they
+	// were stored, because they exceeded the configured limits.
+	HeadersTruncated bool
+	// BodyTruncated is true if only the first part of the body was stored,
+	// because it exceeded the configured maximum body size.
+	BodyTruncated bool
+	// BodySize is the size (in bytes) of the stored body. It's set when a
+	// response log is read from the repository, even if the body itself isn't.
+	BodySize int64
+	// BodyLineCount is the number of lines of the stored body, if it's text,
+	// and nil for binary bodies. Because it's computed from the body, it's only
+	// set when it's selected in a GraphQL query.
+	BodyLineCount *int64
+	// Timings is the breakdown of the duration of the outbound request, if it
+	// was sent by the proxy.
+	Timings Timings
+	// CacheStatus is whether the response was served from a cache, based on
+	// cache indicator headers.
+	CacheStatus CacheStatus
+	// BodyHash is the hex encoded SHA-256 hash of the stored body, or empty if
+	// the body is empty.
+	BodyHash string
+	// HeadersErr is set if the stored headers couldn't be read, e.g. because
+	// of corrupt data. The headers are empty then.
+	HeadersErr error `json:"-"`
+}
+
+// Timings is the duration of the phases of an outbound request. Phases that
+// didn't happen are nil, e.g. DNS, Connect and TLS when a connection was
+// reused.
+type Timings struct {
+	DNS     *time.Duration
+	Connect *time.Duration
+	TLS     *time.Duration
+	// TTFB (time to first byte) is the time from the connection being ready
+	// until the first byte of the response was received.
+	TTFB *time.Duration
+}
+
+// IsZero reports whether none of the phases were recorded.
+func (t Timings) IsZero() bool {
+	return t.DNS == nil && t.Connect == nil && t.TLS == nil && t.TTFB == nil
 }
 
 type Service struct {
-	BypassOutOfScopeRequests bool
+	// BypassOutOfScopeRequests makes the service forward requests that don't
+	// match the scope without logging them. It's set at startup, and isn't
+	// stored in project settings.
+	BypassOutOfScopeRequests bool `json:"-"`
 	FindReqsFilter           FindRequestsFilter
 
-	scope *scope.Scope
-	repo  Repository
+	scope        *scope.Scope
+	repo         Repository
+	resendClient *http.Client
+	maxBodySize  int64
+	skipResBody  bool
+	projService  *proj.Service
+	async        *asyncWriter
+
+	// mu guards shutdown, so that no async writes are started after Shutdown
+	// began waiting for the ones in flight.
+	mu       sync.Mutex
+	shutdown bool
+	writes   sync.WaitGroup
 }
 
 type FindRequestsFilter struct {
 	OnlyInScope   bool
 	SearchExpr    search.Expression `json:"-"`
 	RawSearchExpr string
+	// PathPrefix matches requests with a URL path that starts with the prefix.
+	PathPrefix string
+	// QueryContains matches requests with a raw URL query that contains the
+	// substring.
+	QueryContains string
+	// CaseInsensitive makes all of the above matching case-insensitive. By
+	// default, matching is case-sensitive, including substring matching. This
+	// can be noticeably slower on large projects, because it prevents the use
+	// of database indexes.
+	CaseInsensitive bool
+	// RequestContentType and ResponseContentType match requests with a
+	// `Content-Type` header that starts with the given media type (case
+	// insensitive), e.g. "application/json" also matches
+	// "application/json; charset=utf-8".
+	RequestContentType  string
+	ResponseContentType string
+	// Protos matches requests with any of the protocol versions, in the
+	// format of `http.Request.Proto` (e.g. "HTTP/1.1" or "HTTP/2.0").
+	Protos []string
+	// ResponseSizeMin and ResponseSizeMax match requests with a response body
+	// size (in bytes) within the inclusive range. Either bound is optional.
+	// Responses without a stored body have size 0.
+	ResponseSizeMin *int64
+	ResponseSizeMax *int64
+	// JSONPathMatch matches requests with a JSON body that has a value at a
+	// path.
+	JSONPathMatch *JSONPathMatch
+	// InScope, when set, matches requests that are in scope (true) or out of
+	// scope (false), evaluated against all rules of the active scope. Unlike
+	// OnlyInScope, a scope without rules has no requests in scope.
+	InScope *bool
+	// RequestHeaders and ResponseHeaders match requests with headers that
+	// match all of the given header matches.
+	RequestHeaders  []HeaderMatch
+	ResponseHeaders []HeaderMatch
+	// ConnID matches requests that were received on the client connection
+	// with the ID. Zero matches all requests.
+	ConnID int64
+	// Unread, when set, matches requests that are unread (true) or read
+	// (false).
+	Unread *bool
+	// CacheStatus matches requests with a response with the cache status.
+	// Empty matches all requests.
+	CacheStatus CacheStatus
+	// SNI matches requests with the TLS server name (case-insensitive). Empty
+	// matches all requests.
+	SNI string
+	// Listener matches requests that were captured by the proxy listener with
+	// the address. Empty matches all requests.
+	Listener string
+	// Starred, when set, matches requests that are starred (true) or not
+	// (false).
+	Starred *bool
+	// StatusClasses matches requests with a response with a status code in
+	// any of the classes. Empty matches all requests.
+	StatusClasses []StatusClass
+}
+
+// HeaderMatch matches a header by key (case-insensitive). If Value is set, the
+// header value must also contain it.
+type HeaderMatch struct {
+	Key   string
+	Value string
+}
+
+// JSONPathMatch matches a value in a JSON request body. Path is in dot
+// notation (e.g. "user.roles[0]", optionally prefixed with "$.") or a JSON
+// pointer (e.g. "/user/roles/0"). String values are compared as is, other
+// values by their JSON encoding (e.g. "true" or "42").
+type JSONPathMatch struct {
+	Path  string
+	Value string
 }
 
 type Config struct {
@@ -61,12 +270,43 @@ type Config struct {
 	Repository               Repository
 	ProjectService           *proj.Service
 	BypassOutOfScopeRequests bool
+	// ResendClient sends resent requests. By default, a client that doesn't
+	// follow redirects is used.
+	ResendClient *http.Client
+	// ResendTransport is the transport of the default resend client, e.g. the
+	// transport of the proxy, so resent requests use the same upstream proxy
+	// and client certificates as proxied requests. Defaults to
+	// `http.DefaultTransport`. It's only used if ResendClient isn't set.
+	ResendTransport http.RoundTripper
+	// MaxBodySize is the maximum number of bytes of a request or response body
+	// that is buffered for logging. Larger bodies are still forwarded in full,
+	// but only their first part is stored, and the log is flagged as having a
+	// truncated body. Defaults to 10 MiB.
+	MaxBodySize int64
+	// AsyncWrites makes the service queue request and response logs, which are
+	// stored in batches by a background writer, rather than storing them in a
+	// transaction per log. This improves throughput at the cost of durability:
+	// queued logs are lost if the process exits without Shutdown being called.
+	AsyncWrites bool
+	// AsyncQueueSize is the maximum number of queued logs. When the queue is
+	// full, proxied requests and responses block until there's room. Defaults
+	// to 1000.
+	AsyncQueueSize int
+	// SkipResponseBodies makes the service capture requests and the status
+	// and headers of responses, but not response bodies, which aren't buffered
+	// either. Response logs are flagged as having a skipped body. Unlike
+	// skipping bodies by content type, this applies to all responses.
+	SkipResponseBodies bool
 }
 
 func NewService(cfg Config) *Service {
 	svc := &Service{
 		scope:                    cfg.Scope,
 		repo:                     cfg.Repository,
+		resendClient:             cfg.ResendClient,
+		maxBodySize:              cfg.MaxBodySize,
+		skipResBody:              cfg.SkipResponseBodies,
+		projService:              cfg.ProjectService,
 		BypassOutOfScopeRequests: cfg.BypassOutOfScopeRequests,
 	}
 
@@ -86,17 +326,128 @@ func NewService(cfg Config) *Service {
 		return nil
 	})
 
+	if svc.maxBodySize == 0 {
+		svc.maxBodySize = defaultMaxBodySize
+	}
+
+	if svc.resendClient == nil && cfg.ResendTransport != nil {
+		client := *defaultResendClient
+		client.Transport = cfg.ResendTransport
+		svc.resendClient = &client
+	}
+
+	if cfg.AsyncWrites {
+		svc.async = newAsyncWriter(cfg.Repository, cfg.AsyncQueueSize)
+	}
+
 	return svc
 }
 
+// Shutdown stops logging new requests and responses, waits for pending
+// (async or queued) log writes to be stored, and then closes the project
+// database.
+// Requests that are proxied after Shutdown is called are forwarded, but not
+// logged. If ctx expires before the writes are drained, its error is returned
+// and the database is left open.
+func (svc *Service) Shutdown(ctx context.Context) error {
+	svc.mu.Lock()
+	svc.shutdown = true
+	svc.mu.Unlock()
+
+	done := make(chan struct{})
+
+	go func() {
+		svc.writes.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return fmt.Errorf("reqlog: could not drain pending log writes: %w", ctx.Err())
+	}
+
+	if svc.async != nil {
+		close(svc.async.queue)
+
+		select {
+		case <-svc.async.done:
+		case <-ctx.Done():
+			return fmt.Errorf("reqlog: could not drain queued request logs: %w", ctx.Err())
+		}
+	}
+
+	if err := svc.projService.Close(); err != nil {
+		return fmt.Errorf("reqlog: could not close project: %w", err)
+	}
+
+	return nil
+}
+
+// goWrite calls fn in a goroutine that Shutdown waits for. It returns
+// ErrShutdown, without calling fn, if the service is shut down.
+func (svc *Service) goWrite(fn func()) error {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	if svc.shutdown {
+		return ErrShutdown
+	}
+
+	svc.writes.Add(1)
+
+	go func() {
+		defer svc.writes.Done()
+		fn()
+	}()
+
+	return nil
+}
+
+func (svc *Service) isShutdown() bool {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	return svc.shutdown
+}
+
 func (svc *Service) FindRequests(ctx context.Context) ([]Request, error) {
 	return svc.repo.FindRequestLogs(ctx, svc.FindReqsFilter, svc.scope)
 }
 
+// FindRequestsByFilter returns request logs using filter, instead of the active
+// request log filter.
+func (svc *Service) FindRequestsByFilter(ctx context.Context, filter FindRequestsFilter) ([]Request, error) {
+	return svc.repo.FindRequestLogs(ctx, filter, svc.scope)
+}
+
+// CountRequests returns the number of request logs that match filter.
+func (svc *Service) CountRequests(ctx context.Context, filter FindRequestsFilter) (int64, error) {
+	return svc.repo.CountRequestLogs(ctx, filter, svc.scope)
+}
+
 func (svc *Service) FindRequestLogByID(ctx context.Context, id int64) (Request, error) {
 	return svc.repo.FindRequestLogByID(ctx, id)
 }
 
+// FindRequestLogsByIDs returns the request logs with the IDs, in the order of
+// ids. Unknown IDs are skipped.
+func (svc *Service) FindRequestLogsByIDs(ctx context.Context, ids []int64) ([]Request, error) {
+	return svc.repo.FindRequestLogsByIDs(ctx, ids)
+}
+
+// FindParentRequests returns the request logs a request was made from, based
+// on its `Referer` header.
+func (svc *Service) FindParentRequests(ctx context.Context, id int64) ([]Request, error) {
+	return svc.repo.FindParentRequestLogs(ctx, id)
+}
+
+// FindRequestsBySession returns the request logs that were sent with a session
+// cookie, i.e. a cookie with the name and value.
+func (svc *Service) FindRequestsBySession(ctx context.Context, cookieName, value string) ([]Request, error) {
+	return svc.repo.FindRequestLogsBySession(ctx, cookieName, value)
+}
+
 func (svc *Service) SetRequestLogFilter(ctx context.Context, filter FindRequestsFilter) error {
 	svc.FindReqsFilter = filter
 	return svc.repo.UpsertSettings(ctx, "reqlog", svc)
@@ -106,36 +457,67 @@ func (svc *Service) ClearRequests(ctx context.Context) error {
 	return svc.repo.ClearRequestLogs(ctx)
 }
 
-func (svc *Service) addRequest(
-	ctx context.Context,
-	req http.Request,
-	body []byte,
-	timestamp time.Time,
-) (*Request, error) {
-	return svc.repo.AddRequestLog(ctx, req, body, timestamp)
+// addResponse stores the response log of a request log that was sent, for both
+// synchronous and async writes. The response body is decoded first.
+func addResponse(ctx context.Context, repo Repository, reqLog Request) (*Response, error) {
+	body, err := decodeGzipBody(reqLog.Response.Response.Header, reqLog.Response.Body, reqLog.Response.BodyTruncated)
+	if err != nil {
+		return nil, err
+	}
+
+	reqLog.Response.Body = body
+
+	return repo.AddResponseLog(ctx, reqLog)
+}
+
+// decodeGzipBody decodes a gzip encoded response body, which is how bodies are
+// stored. If partial is true, the body may be cut off, and the data that could
+// be decoded up to that point is returned. Empty bodies (e.g. of HEAD requests,
+// or bodies that weren't captured) are returned as is.
+func decodeGzipBody(header http.Header, body []byte, partial bool) ([]byte, error) {
+	if header.Get("Content-Encoding") != "gzip" || len(body) == 0 {
+		return body, nil
+	}
+
+	gzipReader, err := gzip.NewReader(bytes.NewBuffer(body))
+	if partial && errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reqlog: could not create gzip reader: %w", err)
+	}
+	defer gzipReader.Close()
+
+	decoded, err := ioutil.ReadAll(gzipReader)
+	if partial && errors.Is(err, io.ErrUnexpectedEOF) {
+		return decoded, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reqlog: could not read gzipped response body: %w", err)
+	}
+
+	return decoded, nil
 }
 
-func (svc *Service) addResponse(
-	ctx context.Context,
-	reqID int64,
-	res http.Response,
-	body []byte,
-	timestamp time.Time,
-) (*Response, error) {
-	if res.Header.Get("Content-Encoding") == "gzip" {
-		gzipReader, err := gzip.NewReader(bytes.NewBuffer(body))
-		if err != nil {
-			return nil, fmt.Errorf("reqlog: could not create gzip reader: %w", err)
-		}
-		defer gzipReader.Close()
+// readBody reads up to max bytes of r for logging. The returned reader yields
+// the full, unaltered body, so it can still be forwarded. If the body is larger
+// than max, only its first max bytes are returned, and truncated is true.
+func readBody(r io.ReadCloser, max int64) (body []byte, truncated bool, fwd io.ReadCloser, err error) {
+	body, err = ioutil.ReadAll(io.LimitReader(r, max+1))
+	if err != nil {
+		return nil, false, nil, err
+	}
 
-		body, err = ioutil.ReadAll(gzipReader)
-		if err != nil {
-			return nil, fmt.Errorf("reqlog: could not read gzipped response body: %w", err)
-		}
+	if int64(len(body)) <= max {
+		return body, false, ioutil.NopCloser(bytes.NewReader(body)), nil
 	}
 
-	return svc.repo.AddResponseLog(ctx, reqID, res, body, timestamp)
+	fwd = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), r), r}
+
+	return body[:max], true, fwd, nil
 }
 
 func (svc *Service) RequestModifier(next proxy.RequestModifyFunc) proxy.RequestModifyFunc {
@@ -146,31 +528,67 @@ func (svc *Service) RequestModifier(next proxy.RequestModifyFunc) proxy.RequestM
 
 		clone := req.Clone(req.Context())
 
-		var body []byte
+		var (
+			body      []byte
+			truncated bool
+		)
 
 		if req.Body != nil {
-			// TODO: Use io.LimitReader.
 			var err error
 
-			body, err = ioutil.ReadAll(req.Body)
+			body, truncated, req.Body, err = readBody(req.Body, svc.maxBodySize)
 			if err != nil {
 				log.Printf("[ERROR] Could not read request body for logging: %v", err)
 				return
 			}
-
-			req.Body = ioutil.NopCloser(bytes.NewBuffer(body))
 		}
 
-		// Bypass logging if this setting is enabled and the incoming request
-		// doens't match any rules of the scope.
-		if svc.BypassOutOfScopeRequests && !svc.scope.Match(clone, body) {
+		// Bypass logging if the service is shut down, or if this setting is
+		// enabled and the incoming request doens't match any rules of the scope.
+		if svc.isShutdown() || svc.BypassOutOfScopeRequests && !svc.scope.Match(clone, body) {
 			ctx := context.WithValue(req.Context(), LogBypassedKey, true)
 			*req = *req.WithContext(ctx)
 
 			return
 		}
 
-		reqLog, err := svc.addRequest(req.Context(), *clone, body, now)
+		reqLog := Request{
+			Request:       *clone,
+			Body:          body,
+			BodyTruncated: truncated,
+			Timestamp:     now,
+			ConnID:        proxy.ConnID(req.Context()),
+			Listener:      proxy.Listener(req.Context()),
+		}
+
+		// With async writes, the request log is queued, and its response log
+		// is queued once the response is received.
+		if svc.async != nil {
+			if _, err := svc.projService.ActiveProject(); err != nil {
+				ctx := context.WithValue(req.Context(), LogBypassedKey, true)
+				*req = *req.WithContext(ctx)
+
+				return
+			}
+
+			l := &asyncLog{reqLog: reqLog}
+
+			if err := svc.enqueue(req.Context(), l); err != nil {
+				log.Printf("[ERROR] Could not queue request log: %v", err)
+
+				ctx := context.WithValue(req.Context(), LogBypassedKey, true)
+				*req = *req.WithContext(ctx)
+
+				return
+			}
+
+			ctx := context.WithValue(req.Context(), asyncLogKey, l)
+			*req = *req.WithContext(ctx)
+
+			return
+		}
+
+		stored, err := svc.repo.AddRequestLog(req.Context(), reqLog)
 		if errors.Is(err, proj.ErrNoProject) {
 			ctx := context.WithValue(req.Context(), LogBypassedKey, true)
 			*req = *req.WithContext(ctx)
@@ -181,7 +599,7 @@ func (svc *Service) RequestModifier(next proxy.RequestModifyFunc) proxy.RequestM
 			return
 		}
 
-		ctx := context.WithValue(req.Context(), proxy.ReqIDKey, reqLog.ID)
+		ctx := context.WithValue(req.Context(), proxy.ReqIDKey, stored.ID)
 		*req = *req.WithContext(ctx)
 	}
 }
@@ -198,26 +616,68 @@ func (svc *Service) ResponseModifier(next proxy.ResponseModifyFunc) proxy.Respon
 			return nil
 		}
 
+		pending, _ := res.Request.Context().Value(asyncLogKey).(*asyncLog)
+
 		reqID, _ := res.Request.Context().Value(proxy.ReqIDKey).(int64)
-		if reqID == 0 {
+		if reqID == 0 && pending == nil {
 			return errors.New("reqlog: request is missing ID")
 		}
 
 		clone := *res
 
-		// TODO: Use io.LimitReader.
-		body, err := ioutil.ReadAll(res.Body)
-		if err != nil {
-			return fmt.Errorf("reqlog: could not read response body: %w", err)
+		var (
+			body      []byte
+			truncated bool
+			err       error
+		)
+
+		if !svc.skipResBody {
+			var fwd io.ReadCloser
+
+			origBody := res.Body
+
+			body, truncated, fwd, err = readBody(res.Body, svc.maxBodySize)
+			if err != nil {
+				return fmt.Errorf("reqlog: could not read response body: %w", err)
+			}
+
+			// Bodies that exceed the maximum response size of the proxy are
+			// cut off, so they're partial, too.
+			truncated = truncated || proxy.BodyCapped(origBody)
+
+			res.Body = fwd
 		}
 
-		res.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+		reqLog := Request{
+			ID:         reqID,
+			ServerAddr: proxy.ServerAddr(res.Request.Context()),
+			ClientCert: proxy.ClientCertUsed(res.Request.Context()),
+			Response: &Response{
+				Response:      clone,
+				Body:          body,
+				BodyTruncated: truncated,
+				BodySkipped:   svc.skipResBody,
+				Timestamp:     now,
+				Timings:       Timings(proxy.RequestTimings(res.Request.Context())),
+			},
+		}
 
-		go func() {
-			if _, err := svc.addResponse(context.Background(), reqID, clone, body, now); err != nil {
+		if pending != nil {
+			if err := svc.enqueue(res.Request.Context(), &asyncLog{reqLog: reqLog, parent: pending}); err != nil {
+				log.Printf("[ERROR] Could not queue response log: %v", err)
+			}
+
+			return nil
+		}
+
+		err = svc.goWrite(func() {
+			if _, err := addResponse(context.Background(), svc.repo, reqLog); err != nil {
 				log.Printf("[ERROR] Could not store response log: %v", err)
 			}
-		}()
+		})
+		if err != nil {
+			log.Printf("[ERROR] Could not store response log: %v", err)
+		}
 
 		return nil
 	}
@@ -226,8 +686,27 @@ func (svc *Service) ResponseModifier(next proxy.ResponseModifyFunc) proxy.Respon
 // UnmarshalJSON implements json.Unmarshaler.
 func (f *FindRequestsFilter) UnmarshalJSON(b []byte) error {
 	var dto struct {
-		OnlyInScope   bool
-		RawSearchExpr string
+		OnlyInScope         bool
+		RawSearchExpr       string
+		PathPrefix          string
+		QueryContains       string
+		CaseInsensitive     bool
+		RequestContentType  string
+		ResponseContentType string
+		Protos              []string
+		ResponseSizeMin     *int64
+		ResponseSizeMax     *int64
+		JSONPathMatch       *JSONPathMatch
+		InScope             *bool
+		RequestHeaders      []HeaderMatch
+		ResponseHeaders     []HeaderMatch
+		ConnID              int64
+		Unread              *bool
+		CacheStatus         CacheStatus
+		SNI                 string
+		Listener            string
+		StatusClasses       []StatusClass
+		Starred             *bool
 	}
 
 	if err := json.Unmarshal(b, &dto); err != nil {
@@ -235,8 +714,27 @@ func (f *FindRequestsFilter) UnmarshalJSON(b []byte) error {
 	}
 
 	filter := FindRequestsFilter{
-		OnlyInScope:   dto.OnlyInScope,
-		RawSearchExpr: dto.RawSearchExpr,
+		OnlyInScope:         dto.OnlyInScope,
+		RawSearchExpr:       dto.RawSearchExpr,
+		PathPrefix:          dto.PathPrefix,
+		QueryContains:       dto.QueryContains,
+		CaseInsensitive:     dto.CaseInsensitive,
+		RequestContentType:  dto.RequestContentType,
+		ResponseContentType: dto.ResponseContentType,
+		Protos:              dto.Protos,
+		ResponseSizeMin:     dto.ResponseSizeMin,
+		ResponseSizeMax:     dto.ResponseSizeMax,
+		JSONPathMatch:       dto.JSONPathMatch,
+		InScope:             dto.InScope,
+		RequestHeaders:      dto.RequestHeaders,
+		ResponseHeaders:     dto.ResponseHeaders,
+		ConnID:              dto.ConnID,
+		Unread:              dto.Unread,
+		CacheStatus:         dto.CacheStatus,
+		SNI:                 dto.SNI,
+		Listener:            dto.Listener,
+		StatusClasses:       dto.StatusClasses,
+		Starred:             dto.Starred,
 	}
 
 	if dto.RawSearchExpr != "" {
@@ -258,6 +756,5 @@ func (svc *Service) loadSettings() error {
 }
 
 func (svc *Service) unloadSettings() {
-	svc.BypassOutOfScopeRequests = false
 	svc.FindReqsFilter = FindRequestsFilter{}
 }
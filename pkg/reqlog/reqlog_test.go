@@ -0,0 +1,611 @@
+package reqlog_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/db/sqlite"
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+func newTestService(t *testing.T) (*reqlog.Service, *sqlite.Client) {
+	t.Helper()
+
+	return newTestServiceWithConfig(t, reqlog.Config{})
+}
+
+// newTestServiceWithConfig returns a service for a newly opened project. The
+// scope, project service and repository of cfg are set by the helper.
+func newTestServiceWithConfig(t *testing.T, cfg reqlog.Config) (*reqlog.Service, *sqlite.Client) {
+	t.Helper()
+
+	db, err := sqlite.New(sqlite.Config{ProjectsPath: t.TempDir()})
+	if err != nil {
+		t.Fatalf("could not create database client: %v", err)
+	}
+
+	projService, err := proj.NewService(db)
+	if err != nil {
+		t.Fatalf("could not create project service: %v", err)
+	}
+
+	cfg.Scope = scope.New(db, projService)
+	cfg.ProjectService = projService
+	cfg.Repository = db
+
+	svc := reqlog.NewService(cfg)
+
+	if _, err := projService.Open(context.Background(), "test"); err != nil {
+		t.Fatalf("could not open project: %v", err)
+	}
+
+	t.Cleanup(func() { projService.Close() })
+
+	return svc, db
+}
+
+// addTestRequestLog stores reqLog, and reqLog.Response as its response log if
+// it's set, and returns the stored request log. Zero timestamps are set to the
+// current time.
+func addTestRequestLog(t *testing.T, db *sqlite.Client, reqLog reqlog.Request) *reqlog.Request {
+	t.Helper()
+
+	ctx := context.Background()
+
+	resLog := reqLog.Response
+	reqLog.Response = nil
+
+	if reqLog.Timestamp.IsZero() {
+		reqLog.Timestamp = time.Now()
+	}
+
+	stored, err := db.AddRequestLog(ctx, reqLog)
+	if err != nil {
+		t.Fatalf("could not add request log: %v", err)
+	}
+
+	if resLog == nil {
+		return stored
+	}
+
+	if resLog.Timestamp.IsZero() {
+		resLog.Timestamp = time.Now()
+	}
+
+	stored.Response = resLog
+
+	stored.Response, err = db.AddResponseLog(ctx, *stored)
+	if err != nil {
+		t.Fatalf("could not add response log: %v", err)
+	}
+
+	return stored
+}
+
+// newTestProxy returns a proxy that logs requests and responses using svc.
+func newTestProxy(t *testing.T, svc *reqlog.Service) *proxy.Proxy {
+	t.Helper()
+
+	caCert, caKey, err := proxy.NewCA("Hetty", "Hetty CA", time.Hour)
+	if err != nil {
+		t.Fatalf("could not create CA: %v", err)
+	}
+
+	p, err := proxy.NewProxy(proxy.Config{CACert: caCert, CAKey: caKey})
+	if err != nil {
+		t.Fatalf("could not create proxy: %v", err)
+	}
+
+	p.UseRequestModifier(svc.RequestModifier)
+	p.UseResponseModifier(svc.ResponseModifier)
+
+	return p
+}
+
+func TestBypassOutOfScopeRequests(t *testing.T) {
+	t.Parallel()
+
+	db, err := sqlite.New(sqlite.Config{ProjectsPath: t.TempDir()})
+	if err != nil {
+		t.Fatalf("could not create database client: %v", err)
+	}
+
+	projService, err := proj.NewService(db)
+	if err != nil {
+		t.Fatalf("could not create project service: %v", err)
+	}
+
+	scopeSvc := scope.New(db, projService)
+	svc := reqlog.NewService(reqlog.Config{
+		Scope:                    scopeSvc,
+		ProjectService:           projService,
+		Repository:               db,
+		BypassOutOfScopeRequests: true,
+	})
+
+	ctx := context.Background()
+
+	if _, err := projService.Open(ctx, "test"); err != nil {
+		t.Fatalf("could not open project: %v", err)
+	}
+	defer projService.Close()
+
+	if err := scopeSvc.SetRules(ctx, []scope.Rule{{URL: regexp.MustCompile(`/in-scope`)}}); err != nil {
+		t.Fatalf("could not set scope rules: %v", err)
+	}
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer target.Close()
+
+	p := newTestProxy(t, svc)
+
+	for _, path := range []string{"/out-of-scope", "/in-scope"} {
+		rec := httptest.NewRecorder()
+		p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target.URL+path, nil))
+
+		if rec.Code != http.StatusNoContent {
+			t.Errorf("expected request to %v to be forwarded, got status: %v", path, rec.Code)
+		}
+	}
+
+	reqLogs, err := db.FindRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reqLogs) != 1 {
+		t.Fatalf("expected 1 request log, got: %v", len(reqLogs))
+	}
+
+	if exp := target.URL + "/in-scope"; reqLogs[0].Request.URL.String() != exp {
+		t.Errorf("expected logged request URL %q, got: %q", exp, reqLogs[0].Request.URL.String())
+	}
+}
+
+func TestServerAddr(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer target.Close()
+
+	p := newTestProxy(t, svc)
+
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target.URL, nil))
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected status %v, got: %v", http.StatusNoContent, rec.Code)
+	}
+
+	// The response log is stored asynchronously.
+	var got string
+
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		reqLogs, err := db.FindRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(reqLogs) == 1 && reqLogs[0].ServerAddr != "" {
+			got = reqLogs[0].ServerAddr
+			break
+		}
+	}
+
+	if exp := target.Listener.Addr().String(); got != exp {
+		t.Errorf("expected server address %q, got: %q", exp, got)
+	}
+}
+
+func TestMaxBodySize(t *testing.T) {
+	t.Parallel()
+
+	const maxBodySize = 64
+
+	svc, db := newTestServiceWithConfig(t, reqlog.Config{MaxBodySize: maxBodySize})
+	ctx := context.Background()
+
+	reqBody := bytes.Repeat([]byte("foo"), 100)
+	resBody := bytes.Repeat([]byte("bar"), 100<<10)
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil || !bytes.Equal(body, reqBody) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Length", strconv.Itoa(len(resBody)))
+		w.Write(resBody)
+	}))
+	defer target.Close()
+
+	p := newTestProxy(t, svc)
+
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, target.URL, bytes.NewReader(reqBody)))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %v, got: %v", http.StatusOK, rec.Code)
+	}
+
+	if !bytes.Equal(rec.Body.Bytes(), resBody) {
+		t.Fatalf("expected full response body to be delivered, got %v bytes", rec.Body.Len())
+	}
+
+	// The response log is stored asynchronously.
+	var got reqlog.Request
+
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		reqLogs, err := db.FindRequestLogs(reqlog.WithAllFields(ctx), reqlog.FindRequestsFilter{}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(reqLogs) == 1 && reqLogs[0].Response != nil && reqLogs[0].Response.BodyTruncated {
+			got = reqLogs[0]
+			break
+		}
+	}
+
+	if !got.BodyTruncated {
+		t.Error("expected request log to be flagged as having a truncated body")
+	}
+
+	if !bytes.Equal(got.Body, reqBody[:maxBodySize]) {
+		t.Errorf("expected request body to be stored truncated, got %v bytes", len(got.Body))
+	}
+
+	if got.Response == nil {
+		t.Fatal("expected response log to be flagged as having a truncated body")
+	}
+
+	if !bytes.Equal(got.Response.Body, resBody[:maxBodySize]) {
+		t.Errorf("expected response body to be stored truncated, got %v bytes", len(got.Response.Body))
+	}
+
+	if got.Response.ContentLengthMismatch {
+		t.Error("expected truncated response body not to be flagged as a `Content-Length` mismatch")
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("foobar"))
+	}))
+	defer target.Close()
+
+	p := newTestProxy(t, svc)
+
+	const n = 10
+
+	for i := 0; i < n; i++ {
+		rec := httptest.NewRecorder()
+		p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target.URL, nil))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %v, got: %v", http.StatusOK, rec.Code)
+		}
+	}
+
+	// Response logs are stored asynchronously, and should be flushed by
+	// shutting down right after the responses were received.
+	if err := svc.Shutdown(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Requests proxied after shutdown are forwarded, but not logged.
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target.URL, nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected request to be forwarded after shutdown, got status: %v", rec.Code)
+	}
+
+	if err := db.OpenProject("test"); err != nil {
+		t.Fatalf("could not reopen project: %v", err)
+	}
+
+	reqLogs, err := db.FindRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reqLogs) != n {
+		t.Fatalf("expected %v request logs, got: %v", n, len(reqLogs))
+	}
+
+	for _, reqLog := range reqLogs {
+		if reqLog.Response == nil {
+			t.Errorf("expected response log for request log %v to be stored", reqLog.ID)
+		}
+	}
+}
+
+func TestResponseTimings(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer target.Close()
+
+	// Use a hostname, so the host is resolved when connecting.
+	targetURL := strings.Replace(target.URL, "127.0.0.1", "localhost", 1)
+
+	p := newTestProxy(t, svc)
+
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, targetURL, nil))
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected status %v, got: %v", http.StatusNoContent, rec.Code)
+	}
+
+	// The response log is stored asynchronously.
+	var got reqlog.Timings
+
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		reqLogs, err := db.FindRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(reqLogs) == 1 && reqLogs[0].Response != nil && !reqLogs[0].Response.Timings.IsZero() {
+			got = reqLogs[0].Response.Timings
+			break
+		}
+	}
+
+	// The connection is fresh, so all phases except the TLS handshake happened.
+	if got.DNS == nil {
+		t.Error("expected DNS timing to be recorded")
+	}
+
+	if got.Connect == nil {
+		t.Error("expected connect timing to be recorded")
+	}
+
+	if got.TTFB == nil {
+		t.Error("expected TTFB timing to be recorded")
+	}
+
+	if got.TLS != nil {
+		t.Errorf("expected no TLS timing for a plain HTTP request, got: %v", *got.TLS)
+	}
+}
+
+func TestConnID(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer target.Close()
+
+	p := newTestProxy(t, svc)
+
+	proxySrv := httptest.NewUnstartedServer(p)
+	proxySrv.Config.ConnContext = p.ConnContext
+	proxySrv.Start()
+
+	defer proxySrv.Close()
+
+	proxyURL, err := url.Parse(proxySrv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	send := func(client *http.Client) {
+		t.Helper()
+
+		res, err := client.Get(target.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// Drain the body, so the connection is reused.
+		io.Copy(ioutil.Discard, res.Body)
+		res.Body.Close()
+	}
+
+	// The first client sends two requests on the same (keep-alive)
+	// connection, the second client uses a new connection.
+	client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)}}
+	send(client)
+	send(client)
+
+	otherClient := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)}}
+	send(otherClient)
+
+	var reqLogs []reqlog.Request
+
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		reqLogs, err = db.FindRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(reqLogs) == 3 {
+			break
+		}
+	}
+
+	if len(reqLogs) != 3 {
+		t.Fatalf("expected 3 request logs, got: %v", len(reqLogs))
+	}
+
+	// Request logs are ordered newest first.
+	first, second, other := reqLogs[2], reqLogs[1], reqLogs[0]
+
+	if first.ConnID == 0 {
+		t.Fatal("expected connection ID to be set")
+	}
+
+	if second.ConnID != first.ConnID {
+		t.Errorf("expected requests on the same connection to share ID %v, got: %v", first.ConnID, second.ConnID)
+	}
+
+	if other.ConnID == first.ConnID {
+		t.Errorf("expected request on another connection to have a different ID than %v", first.ConnID)
+	}
+
+	onConn, err := db.FindRequestLogs(ctx, reqlog.FindRequestsFilter{ConnID: first.ConnID}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(onConn) != 2 {
+		t.Errorf("expected 2 request logs on connection %v, got: %v", first.ConnID, len(onConn))
+	}
+}
+
+func TestSkipResponseBodies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		asyncWrites bool
+		gzip        bool
+	}{
+		{name: "sync writes"},
+		{name: "async writes", asyncWrites: true},
+		{name: "gzip encoded", gzip: true},
+		{name: "gzip encoded with async writes", asyncWrites: true, gzip: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc, db := newTestServiceWithConfig(t, reqlog.Config{
+				SkipResponseBodies: true,
+				AsyncWrites:        tt.asyncWrites,
+			})
+			ctx := context.Background()
+
+			resBody := []byte("foobar")
+			if tt.gzip {
+				resBody = gzipBytes(t, resBody)
+			}
+
+			target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Foo", "bar")
+				if tt.gzip {
+					w.Header().Set("Content-Encoding", "gzip")
+				}
+				w.WriteHeader(http.StatusTeapot)
+				w.Write(resBody)
+			}))
+			defer target.Close()
+
+			p := newTestProxy(t, svc)
+
+			req := httptest.NewRequest(http.MethodPost, target.URL, strings.NewReader("request body"))
+			if tt.gzip {
+				// Otherwise, the transport decodes the response body itself.
+				req.Header.Set("Accept-Encoding", "gzip")
+			}
+
+			rec := httptest.NewRecorder()
+			p.ServeHTTP(rec, req)
+
+			if !bytes.Equal(rec.Body.Bytes(), resBody) {
+				t.Errorf("expected response body to be forwarded, got: %q", rec.Body.String())
+			}
+
+			if err := svc.Flush(ctx); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got reqlog.Request
+
+			for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+				reqLogs, err := db.FindRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if len(reqLogs) == 1 && reqLogs[0].Response != nil && reqLogs[0].Response.BodySkipped {
+					got = reqLogs[0]
+					break
+				}
+			}
+
+			if got.Response == nil {
+				t.Fatal("expected response log with skipped body")
+			}
+
+			if string(got.Body) != "request body" {
+				t.Errorf("expected request body to be stored, got: %q", got.Body)
+			}
+
+			if got.Response.Response.StatusCode != http.StatusTeapot {
+				t.Errorf("expected status code %v, got: %v", http.StatusTeapot, got.Response.Response.StatusCode)
+			}
+
+			if v := got.Response.Response.Header.Get("X-Foo"); v != "bar" {
+				t.Errorf("expected response headers to be stored, got `X-Foo` header: %q", v)
+			}
+
+			if len(got.Response.Body) != 0 {
+				t.Errorf("expected no response body to be stored, got: %q", got.Response.Body)
+			}
+
+			if got.Response.ContentLengthMismatch {
+				t.Error("expected skipped response body not to be a content length mismatch")
+			}
+		})
+	}
+}
+
+func gzipBytes(t *testing.T, b []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	gw := gzip.NewWriter(&buf)
+
+	if _, err := gw.Write(b); err != nil {
+		t.Fatalf("could not write gzip data: %v", err)
+	}
+
+	if err := gw.Close(); err != nil {
+		t.Fatalf("could not close gzip writer: %v", err)
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,272 @@
+package reqlog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
+)
+
+type allFieldsKey struct{}
+
+// WithAllFields returns a context that makes repositories return request logs
+// with all their fields, instead of only the fields that are selected by the
+// GraphQL operation of ctx (if any). This is needed when request logs are used
+// for more than presentation, e.g. when they are resent.
+func WithAllFields(ctx context.Context) context.Context {
+	return context.WithValue(ctx, allFieldsKey{}, true)
+}
+
+// AllFields reports whether ctx was derived from WithAllFields.
+func AllFields(ctx context.Context) bool {
+	allFields, _ := ctx.Value(allFieldsKey{}).(bool)
+	return allFields
+}
+
+// maxResendRedirects is the maximum number of redirects that are followed for a
+// resent request.
+const maxResendRedirects = 10
+
+// defaultResendClient sends resent requests. Like the proxy, it doesn't follow
+// redirects, so the response of the request itself is stored. Redirects are
+// followed by the service instead, if requested, so each hop is stored.
+var defaultResendClient = &http.Client{
+	Timeout: 30 * time.Second,
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// ResendOptions modify the headers of a request before it's resent, e.g. to
+// change the `User-Agent`, or to drop conditional headers. RemoveHeaders are
+// removed first, and then SetHeaders replace all values of the same keys. A
+// `Host` header sets the host of the request.
+type ResendOptions struct {
+	SetHeaders    http.Header
+	RemoveHeaders []string
+	// FollowRedirects makes the service follow redirects of the resent request
+	// (up to 10). Each hop is stored as a request log, linked to the request
+	// log it was redirected from.
+	FollowRedirects bool
+}
+
+func (opts ResendOptions) validate() error {
+	for key, values := range opts.SetHeaders {
+		if !isToken(key) {
+			return fmt.Errorf("%w: invalid header key (%q)", ErrInvalidRequest, key)
+		}
+
+		for _, value := range values {
+			// Line breaks would inject headers, or split the request.
+			if strings.ContainsAny(value, "\r\n\x00") {
+				return fmt.Errorf("%w: invalid value for header %q", ErrInvalidRequest, key)
+			}
+		}
+	}
+
+	for _, key := range opts.RemoveHeaders {
+		if !isToken(key) {
+			return fmt.Errorf("%w: invalid header key (%q)", ErrInvalidRequest, key)
+		}
+	}
+
+	return nil
+}
+
+func (opts ResendOptions) apply(req *http.Request) {
+	for _, key := range opts.RemoveHeaders {
+		if http.CanonicalHeaderKey(key) == "Host" {
+			req.Host = ""
+			continue
+		}
+
+		req.Header.Del(key)
+	}
+
+	for key, values := range opts.SetHeaders {
+		if http.CanonicalHeaderKey(key) == "Host" {
+			if len(values) > 0 {
+				req.Host = values[0]
+			}
+
+			continue
+		}
+
+		req.Header.Del(key)
+
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+}
+
+// ResendRequest sends a stored request again, and stores it with its response
+// as a new request log. The request is modified by opts first, and is stored as
+// it was sent. If redirects are followed, the request log of the last hop is
+// returned.
+func (svc *Service) ResendRequest(ctx context.Context, id int64, opts ResendOptions) (*Request, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	orig, err := svc.repo.FindRequestLogByID(WithAllFields(ctx), id)
+	if err != nil {
+		return nil, err
+	}
+
+	reqLog, _, err := svc.resend(ctx, orig, opts, 0)
+	if err != nil || !opts.FollowRedirects {
+		return reqLog, err
+	}
+
+	for i := 0; i < maxResendRedirects; i++ {
+		next, ok := redirectRequest(*reqLog)
+		if !ok {
+			break
+		}
+
+		nextLog, _, err := svc.resend(ctx, next, ResendOptions{}, reqLog.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		reqLog = nextLog
+	}
+
+	return reqLog, nil
+}
+
+// redirectRequest returns the request that follows the redirect response of a
+// request log, like `http.Client` does: 301, 302 and 303 responses change the
+// method to GET (except for HEAD requests) and drop the body, and credentials
+// aren't sent to other hosts. It reports false if the response isn't a
+// redirect.
+func redirectRequest(prev Request) (Request, bool) {
+	if prev.Response == nil || prev.Request.URL == nil {
+		return Request{}, false
+	}
+
+	res := prev.Response.Response
+
+	switch res.StatusCode {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther,
+		http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+	default:
+		return Request{}, false
+	}
+
+	loc := res.Header.Get("Location")
+	if loc == "" {
+		return Request{}, false
+	}
+
+	u, err := prev.Request.URL.Parse(loc)
+	if err != nil {
+		return Request{}, false
+	}
+
+	next := Request{
+		Request: http.Request{
+			Method: prev.Request.Method,
+			URL:    u,
+			Proto:  prev.Request.Proto,
+			Header: prev.Request.Header.Clone(),
+		},
+		Body: prev.Body,
+	}
+
+	if next.Request.Header == nil {
+		next.Request.Header = make(http.Header)
+	}
+
+	switch res.StatusCode {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther:
+		if next.Request.Method != http.MethodGet && next.Request.Method != http.MethodHead {
+			next.Request.Method = http.MethodGet
+		}
+
+		next.Body = nil
+
+		next.Request.Header.Del("Content-Length")
+		next.Request.Header.Del("Content-Type")
+	}
+
+	if !strings.EqualFold(u.Host, prev.Request.URL.Host) {
+		for _, key := range []string{"Authorization", "Www-Authenticate", "Cookie", "Cookie2"} {
+			next.Request.Header.Del(key)
+		}
+	}
+
+	return next, true
+}
+
+// resend sends orig, modified by opts, and stores it with its response as a
+// new request log. The new request log is linked to redirectedFrom, if it's not
+// 0. It returns the duration from sending the request until its response body
+// was read.
+func (svc *Service) resend(ctx context.Context, orig Request, opts ResendOptions, redirectedFrom int64) (*Request, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, orig.Request.Method, orig.Request.URL.String(), bytes.NewReader(orig.Body))
+	if err != nil {
+		return nil, 0, fmt.Errorf("reqlog: could not create request: %w", err)
+	}
+
+	req.Header = orig.Request.Header.Clone()
+	if req.Header == nil {
+		req.Header = make(http.Header)
+	}
+
+	if orig.Request.Host != "" {
+		req.Host = orig.Request.Host
+	}
+
+	opts.apply(req)
+
+	req = proxy.TraceRequest(req)
+
+	client := svc.resendClient
+	if client == nil {
+		client = defaultResendClient
+	}
+
+	timestamp := time.Now()
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("reqlog: could not send request: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, truncated, _, err := readBody(res.Body, svc.maxBodySize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("reqlog: could not read response body: %w", err)
+	}
+
+	latency := time.Since(timestamp)
+
+	body, err = decodeGzipBody(res.Header, body, truncated)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	reqLog, err := svc.repo.AddRequestResponse(ctx, Request{
+		Request:        *req,
+		Body:           orig.Body,
+		Timestamp:      timestamp,
+		RedirectedFrom: redirectedFrom,
+		ClientCert:     proxy.ClientCertUsed(req.Context()),
+		Response: &Response{
+			Response:      *res,
+			Body:          body,
+			BodyTruncated: truncated,
+		},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return reqLog, latency, nil
+}
@@ -0,0 +1,26 @@
+package reqlog
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+var ErrResponseNotFound = errors.New("reqlog: response not found")
+
+// ResponseBody is a stored response body, with the headers of its response.
+// It's for reading bodies that are too large to load in memory at once.
+type ResponseBody struct {
+	Header    http.Header
+	Size      int64
+	Timestamp time.Time
+	Body      io.ReadSeeker
+}
+
+// ResponseBody returns the response body of a request log. The body is only
+// readable until ctx is done.
+func (svc *Service) ResponseBody(ctx context.Context, reqID int64) (ResponseBody, error) {
+	return svc.repo.FindResponseBody(ctx, reqID)
+}
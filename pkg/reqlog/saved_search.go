@@ -0,0 +1,42 @@
+package reqlog
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+var ErrSavedSearchNotFound = errors.New("reqlog: saved search not found")
+
+// SavedSearch is a named request log filter, for reuse across sessions.
+type SavedSearch struct {
+	ID     int64
+	Name   string
+	Filter FindRequestsFilter
+	// CreatedAt and UpdatedAt are in UTC. They are zero for saved searches
+	// stored before they were tracked.
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (svc *Service) SaveSearch(ctx context.Context, name string, filter FindRequestsFilter) (SavedSearch, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return SavedSearch{}, errors.New("reqlog: saved search name cannot be empty")
+	}
+
+	return svc.repo.SaveSearch(ctx, name, filter)
+}
+
+func (svc *Service) SavedSearches(ctx context.Context) ([]SavedSearch, error) {
+	return svc.repo.FindSavedSearches(ctx)
+}
+
+func (svc *Service) DeleteSavedSearch(ctx context.Context, id int64) error {
+	return svc.repo.DeleteSavedSearch(ctx, id)
+}
+
+func (svc *Service) FindSavedSearchByID(ctx context.Context, id int64) (SavedSearch, error) {
+	return svc.repo.FindSavedSearchByID(ctx, id)
+}
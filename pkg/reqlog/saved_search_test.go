@@ -0,0 +1,53 @@
+package reqlog_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+func TestSavedSearches(t *testing.T) {
+	t.Parallel()
+
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	expr, err := search.ParseQuery("req.method = POST")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	saved, err := svc.SaveSearch(ctx, "Only POST", reqlog.FindRequestsFilter{
+		SearchExpr:    expr,
+		RawSearchExpr: "req.method = POST",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	savedSearches, err := svc.SavedSearches(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(savedSearches) != 1 {
+		t.Fatalf("expected 1 saved search, got: %v", len(savedSearches))
+	}
+
+	if got := savedSearches[0]; got.ID != saved.ID || got.Name != "Only POST" ||
+		got.Filter.RawSearchExpr != "req.method = POST" || got.Filter.SearchExpr == nil {
+		t.Errorf("unexpected saved search: %+v", got)
+	}
+
+	if err := svc.DeleteSavedSearch(ctx, saved.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = svc.FindSavedSearchByID(ctx, saved.ID)
+	if !errors.Is(err, reqlog.ErrSavedSearchNotFound) {
+		t.Errorf("expected saved search not found error, got: %v", err)
+	}
+}
@@ -0,0 +1,49 @@
+package reqlog_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestFindRequestsBySession(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	addReqLog := func(cookies ...string) int64 {
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+		for _, cookie := range cookies {
+			req.Header.Add("Cookie", cookie)
+		}
+
+		return addTestRequestLog(t, db, reqlog.Request{Request: *req}).ID
+	}
+
+	first := addReqLog("session=abc")
+	addReqLog("session=def")
+	second := addReqLog("foo=bar; session=abc; baz=qux")
+	addReqLog("other_session=abc")
+	addReqLog()
+	addReqLog("session=abcd")
+	third := addReqLog("foo=bar", "session=abc")
+
+	got, err := svc.FindRequestsBySession(ctx, "session", "abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	gotIDs := make([]int64, len(got))
+	for i, reqLog := range got {
+		gotIDs[i] = reqLog.ID
+	}
+
+	if exp := []int64{third, second, first}; !reflect.DeepEqual(exp, gotIDs) {
+		t.Errorf("expected request logs %v, got: %v", exp, gotIDs)
+	}
+}
@@ -0,0 +1,94 @@
+package reqlog_test
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestSNI(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	tlsTarget := httptest.NewTLSServer(handler)
+	defer tlsTarget.Close()
+
+	plainTarget := httptest.NewServer(handler)
+	defer plainTarget.Close()
+
+	proxySrv := httptest.NewServer(newTestProxy(t, svc))
+	defer proxySrv.Close()
+
+	proxyURL, err := url.Parse(proxySrv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The client indicates a server name that differs from the host it sends
+	// requests to, like with domain fronting. Requests are logged before
+	// they're forwarded, so the (failing) upstream TLS handshake is irrelevant.
+	client := &http.Client{Transport: &http.Transport{
+		Proxy: http.ProxyURL(proxyURL),
+		TLSClientConfig: &tls.Config{
+			ServerName:         "front.example.com",
+			InsecureSkipVerify: true, // nolint:gosec
+		},
+	}}
+
+	for _, u := range []string{tlsTarget.URL, plainTarget.URL} {
+		res, err := client.Get(u)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		res.Body.Close()
+	}
+
+	reqLogs, err := db.FindRequestLogs(ctx, reqlog.FindRequestsFilter{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reqLogs) != 2 {
+		t.Fatalf("expected 2 request logs, got: %v", len(reqLogs))
+	}
+
+	sniByHost := make(map[string]string)
+	for _, reqLog := range reqLogs {
+		sniByHost[reqLog.Request.Host] = reqLog.SNI
+	}
+
+	tlsHost := tlsTarget.Listener.Addr().String()
+
+	if exp := "front.example.com"; sniByHost[tlsHost] != exp {
+		t.Errorf("expected SNI %q for host %q, got: %q", exp, tlsHost, sniByHost[tlsHost])
+	}
+
+	if plainHost := plainTarget.Listener.Addr().String(); sniByHost[plainHost] != "" {
+		t.Errorf("expected no SNI for plaintext request, got: %q", sniByHost[plainHost])
+	}
+
+	reqLogs, err = db.FindRequestLogs(ctx, reqlog.FindRequestsFilter{SNI: "FRONT.example.com"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reqLogs) != 1 {
+		t.Fatalf("expected 1 request log with SNI, got: %v", len(reqLogs))
+	}
+
+	if reqLogs[0].Request.Host != tlsHost {
+		t.Errorf("expected host %q, got: %q", tlsHost, reqLogs[0].Request.Host)
+	}
+}
@@ -0,0 +1,8 @@
+package reqlog
+
+import "context"
+
+// SetStarred stars (or unstars) a request log, to bookmark it.
+func (svc *Service) SetStarred(ctx context.Context, id int64, starred bool) error {
+	return svc.repo.SetRequestLogStarred(ctx, id, starred)
+}
@@ -0,0 +1,28 @@
+package reqlog
+
+// StatusClass is the class of a response status code, e.g. client errors for
+// 4xx status codes.
+type StatusClass string
+
+const (
+	StatusClassInformational StatusClass = "INFORMATIONAL"
+	StatusClassSuccess       StatusClass = "SUCCESS"
+	StatusClassRedirect      StatusClass = "REDIRECT"
+	StatusClassClientError   StatusClass = "CLIENT_ERROR"
+	StatusClassServerError   StatusClass = "SERVER_ERROR"
+)
+
+var statusClassRanges = map[StatusClass][2]int{
+	StatusClassInformational: {100, 199},
+	StatusClassSuccess:       {200, 299},
+	StatusClassRedirect:      {300, 399},
+	StatusClassClientError:   {400, 499},
+	StatusClassServerError:   {500, 599},
+}
+
+// Range returns the inclusive range of status codes of the class. It reports
+// false if the class is unknown.
+func (c StatusClass) Range() (min, max int, ok bool) {
+	r, ok := statusClassRanges[c]
+	return r[0], r[1], ok
+}
@@ -0,0 +1,9 @@
+package reqlog
+
+import "context"
+
+// DistinctStatusCodes returns the distinct response status codes of request
+// logs matching a filter, in ascending order.
+func (svc *Service) DistinctStatusCodes(ctx context.Context, filter FindRequestsFilter) ([]int, error) {
+	return svc.repo.FindDistinctStatusCodes(ctx, filter, svc.scope)
+}
@@ -0,0 +1,86 @@
+package reqlog_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestDistinctStatusCodes(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	seed := []struct {
+		url        string
+		statusCode int
+	}{
+		{url: "https://example.com/foo", statusCode: http.StatusNotFound},
+		{url: "https://example.com/foo/bar", statusCode: http.StatusOK},
+		{url: "https://example.com/", statusCode: http.StatusInternalServerError},
+		{url: "https://example.com/foo", statusCode: http.StatusOK},
+		{url: "https://example.com/baz", statusCode: http.StatusFound},
+		// Pending request, without a response.
+		{url: "https://example.com/foo/pending"},
+	}
+
+	for _, s := range seed {
+		reqLog := reqlog.Request{Request: *httptest.NewRequest(http.MethodGet, s.url, nil)}
+
+		if s.statusCode != 0 {
+			reqLog.Response = &reqlog.Response{Response: http.Response{
+				StatusCode: s.statusCode,
+				Status:     http.StatusText(s.statusCode),
+				Proto:      "HTTP/1.1",
+				ProtoMajor: 1,
+				ProtoMinor: 1,
+				Header:     http.Header{},
+			}}
+		}
+
+		addTestRequestLog(t, db, reqLog)
+	}
+
+	tests := []struct {
+		name   string
+		filter reqlog.FindRequestsFilter
+		exp    []int
+	}{
+		{
+			name:   "no filter",
+			filter: reqlog.FindRequestsFilter{},
+			exp:    []int{http.StatusOK, http.StatusFound, http.StatusNotFound, http.StatusInternalServerError},
+		},
+		{
+			name:   "filtered by path prefix",
+			filter: reqlog.FindRequestsFilter{PathPrefix: "/foo"},
+			exp:    []int{http.StatusOK, http.StatusNotFound},
+		},
+		{
+			name:   "no matches",
+			filter: reqlog.FindRequestsFilter{PathPrefix: "/qux"},
+			exp:    []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := svc.DistinctStatusCodes(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.exp, got) {
+				t.Errorf("expected status codes %v, got: %v", tt.exp, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package reqlog
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// Tag is a label for request logs.
+type Tag struct {
+	Name  string
+	Color string
+}
+
+// TagRequests tags all request logs matching a filter, and returns the number
+// of request logs that were newly tagged. If color is not nil, it's set on the
+// tag, including for request logs that were tagged before.
+func (svc *Service) TagRequests(ctx context.Context, filter FindRequestsFilter, name string, color *string) (int64, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return 0, errors.New("reqlog: tag name cannot be empty")
+	}
+
+	return svc.repo.TagRequestLogs(ctx, filter, svc.scope, name, color)
+}
+
+// UntagRequests removes a tag from all request logs matching a filter, and
+// returns the number of request logs the tag was removed from.
+func (svc *Service) UntagRequests(ctx context.Context, filter FindRequestsFilter, name string) (int64, error) {
+	return svc.repo.UntagRequestLogs(ctx, filter, svc.scope, strings.TrimSpace(name))
+}
+
+// RequestTags returns the tags of a request log.
+func (svc *Service) RequestTags(ctx context.Context, reqID int64) ([]Tag, error) {
+	return svc.repo.FindRequestLogTags(ctx, reqID)
+}
@@ -0,0 +1,93 @@
+package reqlog_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/search"
+)
+
+func TestTagRequests(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newTestService(t)
+	ctx := context.Background()
+
+	var postIDs, getIDs []int64
+
+	for _, method := range []string{http.MethodPost, http.MethodGet, http.MethodPost, http.MethodGet, http.MethodPost} {
+		req := httptest.NewRequest(method, "https://example.com/", nil)
+		reqLog := addTestRequestLog(t, db, reqlog.Request{Request: *req})
+
+		if method == http.MethodPost {
+			postIDs = append(postIDs, reqLog.ID)
+		} else {
+			getIDs = append(getIDs, reqLog.ID)
+		}
+	}
+
+	expr, err := search.ParseQuery("req.method = POST")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	filter := reqlog.FindRequestsFilter{SearchExpr: expr}
+	color := "#ff0000"
+
+	n, err := svc.TagRequests(ctx, filter, "posts", &color)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n != int64(len(postIDs)) {
+		t.Errorf("expected %v tagged request logs, got: %v", len(postIDs), n)
+	}
+
+	// Tagging again shouldn't count request logs that already have the tag.
+	n, err = svc.TagRequests(ctx, filter, "posts", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n != 0 {
+		t.Errorf("expected 0 tagged request logs, got: %v", n)
+	}
+
+	for _, id := range postIDs {
+		tags, err := svc.RequestTags(ctx, id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(tags) != 1 || tags[0].Name != "posts" || tags[0].Color != color {
+			t.Errorf("expected request log %v to have tag `posts` with color %q, got: %+v", id, color, tags)
+		}
+	}
+
+	for _, id := range getIDs {
+		tags, err := svc.RequestTags(ctx, id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(tags) != 0 {
+			t.Errorf("expected request log %v to have no tags, got: %+v", id, tags)
+		}
+	}
+
+	n, err = svc.UntagRequests(ctx, reqlog.FindRequestsFilter{}, "posts")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n != int64(len(postIDs)) {
+		t.Errorf("expected %v untagged request logs, got: %v", len(postIDs), n)
+	}
+
+	if _, err := svc.TagRequests(ctx, filter, " ", nil); err == nil {
+		t.Error("expected error for empty tag name")
+	}
+}
@@ -1,8 +1,12 @@
 package scope
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type Repository interface {
 	UpsertSettings(ctx context.Context, module string, settings interface{}) error
 	FindSettingsByModule(ctx context.Context, module string, settings interface{}) error
+	FindSettingsTimestamps(ctx context.Context, module string) (createdAt, updatedAt time.Time, err error)
 }
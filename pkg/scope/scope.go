@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"regexp"
 	"sync"
+	"time"
 
 	"github.com/dstotijn/hetty/pkg/proj"
 )
@@ -25,6 +26,9 @@ type Rule struct {
 	URL    *regexp.Regexp
 	Header Header
 	Body   *regexp.Regexp
+	// Methods, if not empty, restricts the rule to requests with one of these
+	// (uppercase) methods. A rule with only methods matches on method alone.
+	Methods []string
 }
 
 type Header struct {
@@ -100,6 +104,12 @@ func (s *Scope) SetRules(ctx context.Context, rules []Rule) error {
 	return nil
 }
 
+// Timestamps returns when the rules were first set, and when they were last
+// changed. It returns proj.ErrNoSettings if rules were never set.
+func (s *Scope) Timestamps(ctx context.Context) (createdAt, updatedAt time.Time, err error) {
+	return s.repo.FindSettingsTimestamps(ctx, moduleName)
+}
+
 func (s *Scope) Match(req *http.Request, body []byte) bool {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -114,6 +124,16 @@ func (s *Scope) Match(req *http.Request, body []byte) bool {
 }
 
 func (r Rule) Match(req *http.Request, body []byte) bool {
+	if len(r.Methods) > 0 {
+		if !r.matchMethod(req.Method) {
+			return false
+		}
+
+		if r.URL == nil && r.Header.Key == nil && r.Header.Value == nil && r.Body == nil {
+			return true
+		}
+	}
+
 	if r.URL != nil {
 		if matches := r.URL.MatchString(req.URL.String()); matches {
 			return true
@@ -158,6 +178,16 @@ func (r Rule) Match(req *http.Request, body []byte) bool {
 	return false
 }
 
+func (r Rule) matchMethod(method string) bool {
+	for _, m := range r.Methods {
+		if m == method {
+			return true
+		}
+	}
+
+	return false
+}
+
 // MarshalJSON implements json.Marshaler.
 func (r Rule) MarshalJSON() ([]byte, error) {
 	type (
@@ -166,9 +196,10 @@ func (r Rule) MarshalJSON() ([]byte, error) {
 			Value string
 		}
 		ruleDTO struct {
-			URL    string
-			Header headerDTO
-			Body   string
+			URL     string
+			Header  headerDTO
+			Body    string
+			Methods []string
 		}
 	)
 
@@ -178,7 +209,8 @@ func (r Rule) MarshalJSON() ([]byte, error) {
 			Key:   regexpToString(r.Header.Key),
 			Value: regexpToString(r.Header.Value),
 		},
-		Body: regexpToString(r.Body),
+		Body:    regexpToString(r.Body),
+		Methods: r.Methods,
 	}
 
 	return json.Marshal(dto)
@@ -192,9 +224,10 @@ func (r *Rule) UnmarshalJSON(data []byte) error {
 			Value string
 		}
 		ruleDTO struct {
-			URL    string
-			Header headerDTO
-			Body   string
+			URL     string
+			Header  headerDTO
+			Body    string
+			Methods []string
 		}
 	)
 
@@ -229,7 +262,8 @@ func (r *Rule) UnmarshalJSON(data []byte) error {
 			Key:   headerKey,
 			Value: headerValue,
 		},
-		Body: body,
+		Body:    body,
+		Methods: dto.Methods,
 	}
 
 	return nil